@@ -0,0 +1,22 @@
+data "taikun_cloud_regions" "openstack" {
+  openstack {
+    url      = "https://openstack.example.com:5000/v3"
+    domain   = "Default"
+    user     = "my-user"
+    password = "my-password"
+  }
+}
+
+data "taikun_cloud_availability_zones" "openstack" {
+  openstack {
+    url      = "https://openstack.example.com:5000/v3"
+    domain   = "Default"
+    user     = "my-user"
+    password = "my-password"
+  }
+  region = data.taikun_cloud_regions.openstack.regions[0]
+}
+
+data "taikun_cloud_availability_zones" "existing" {
+  cloud_credential_id = "42"
+}
@@ -0,0 +1,4 @@
+data "taikun_cloud_credential" "foo" {
+  name            = "my-cloud-credential"
+  organization_id = "42"
+}
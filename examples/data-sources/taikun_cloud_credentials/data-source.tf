@@ -0,0 +1,4 @@
+data "taikun_cloud_credentials" "foo" {
+  organization_id = "42"
+  cloud_type      = "OpenStack"
+}
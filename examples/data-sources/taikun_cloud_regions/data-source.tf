@@ -0,0 +1,19 @@
+data "taikun_cloud_regions" "aws" {
+  aws {
+    access_key_id     = "my-access-key-id"
+    secret_access_key = "my-secret-access-key"
+  }
+}
+
+data "taikun_cloud_regions" "azure" {
+  azure {
+    client_id       = "my-client-id"
+    client_secret   = "my-client-secret"
+    subscription_id = "my-subscription-id"
+    tenant_id       = "my-tenant-id"
+  }
+}
+
+data "taikun_cloud_regions" "existing" {
+  cloud_credential_id = "42"
+}
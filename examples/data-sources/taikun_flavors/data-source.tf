@@ -10,3 +10,13 @@ data "taikun_flavors" "foo" {
   min_ram = 32
   max_ram = 256
 }
+
+data "taikun_flavors" "smallest" {
+  cloud_credential_id = resource.taikun_cloud_credential_openstack.foo.id
+
+  min_cpu       = 4
+  min_ram       = 8
+  name_regex    = "^m[0-9]+\\."
+  gpu           = false
+  most_suitable = true
+}
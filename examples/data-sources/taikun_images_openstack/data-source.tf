@@ -5,3 +5,11 @@ resource "taikun_cloud_credential_openstack" "foo" {
 data "taikun_images_openstack" "foo" {
   cloud_credential_id = resource.taikun_cloud_credential_openstack.foo.id
 }
+
+data "taikun_images_openstack" "ubuntu" {
+  cloud_credential_id = resource.taikun_cloud_credential_openstack.foo.id
+
+  os_family   = "ubuntu"
+  os_version  = "22.04"
+  most_recent = true
+}
@@ -0,0 +1,13 @@
+data "taikun_kubernetes_versions" "foo" {
+}
+
+data "taikun_kubernetes_versions" "v1_22" {
+  name_regex = "^v1\\.22\\."
+}
+
+resource "taikun_project" "foo" {
+  name                = "foo"
+  cloud_credential_id = "42"
+
+  kubernetes_version = data.taikun_kubernetes_versions.foo.latest_version
+}
@@ -0,0 +1,18 @@
+data "taikun_openstack_networks" "public" {
+  url          = "https://openstack.example.com:5000/v3"
+  domain       = "Default"
+  user         = "my-user"
+  password     = "my-password"
+  project_name = "my-project"
+  region       = "RegionOne"
+
+  filter {
+    name   = "name"
+    values = ["public"]
+  }
+}
+
+resource "taikun_cloud_credential_openstack" "foo" {
+  name                = "foo"
+  public_network_name = data.taikun_openstack_networks.public.networks[0].name
+}
@@ -0,0 +1,15 @@
+data "taikun_openstack_subnets" "foo" {
+  url          = "https://openstack.example.com:5000/v3"
+  domain       = "Default"
+  user         = "my-user"
+  password     = "my-password"
+  project_name = "my-project"
+  region       = "RegionOne"
+
+  name_regex = "^kubernetes-"
+}
+
+resource "taikun_cloud_credential_openstack" "foo" {
+  name                       = "foo"
+  imported_network_subnet_id = data.taikun_openstack_subnets.foo.subnets[0].id
+}
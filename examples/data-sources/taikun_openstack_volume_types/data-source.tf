@@ -0,0 +1,18 @@
+data "taikun_openstack_volume_types" "ssd" {
+  url          = "https://openstack.example.com:5000/v3"
+  domain       = "Default"
+  user         = "my-user"
+  password     = "my-password"
+  project_name = "my-project"
+  region       = "RegionOne"
+
+  filter {
+    name   = "name"
+    values = ["ssd"]
+  }
+}
+
+resource "taikun_cloud_credential_openstack" "foo" {
+  name             = "foo"
+  volume_type_name = data.taikun_openstack_volume_types.ssd.volume_types[0].name
+}
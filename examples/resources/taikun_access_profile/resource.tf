@@ -3,7 +3,12 @@ resource "taikun_access_profile" "foo" {
 
   organization_id = "42"
   lock            = true
-  http_proxy      = "proxy_url"
+
+  http_proxy {
+    url      = "http://proxy.example.com:3128"
+    username = "proxy_user"
+    password = "proxy_password"
+  }
 
   ssh_user {
     name       = "oui oui"
@@ -0,0 +1 @@
+terraform import taikun_access_profile_allowed_host.myallowedhost 42/1234
@@ -0,0 +1,11 @@
+resource "taikun_access_profile" "foo" {
+  name                        = "foo"
+  non_exclusive_sub_resources = true
+}
+
+resource "taikun_access_profile_allowed_host" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  description       = "Host A"
+  address           = "10.0.0.1"
+  mask_bits         = 24
+}
@@ -0,0 +1 @@
+terraform import taikun_access_profile_dns_server.mydnsserver 42/1234
@@ -0,0 +1,9 @@
+resource "taikun_access_profile" "foo" {
+  name                        = "foo"
+  non_exclusive_sub_resources = true
+}
+
+resource "taikun_access_profile_dns_server" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  address           = "8.8.8.8"
+}
@@ -0,0 +1 @@
+terraform import taikun_access_profile_ntp_server.myntpserver 42/1234
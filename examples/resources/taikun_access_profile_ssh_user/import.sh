@@ -0,0 +1 @@
+terraform import taikun_access_profile_ssh_user.mysshuser 42/1234
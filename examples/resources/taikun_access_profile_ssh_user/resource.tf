@@ -0,0 +1,10 @@
+resource "taikun_access_profile" "foo" {
+  name                        = "foo"
+  non_exclusive_sub_resources = true
+}
+
+resource "taikun_access_profile_ssh_user" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  name              = "oui_oui"
+  public_key        = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGQwGpzLk0IzqKnBpaHqecLA+X4zfHamNe9Rg3CoaXHF :oui_oui:"
+}
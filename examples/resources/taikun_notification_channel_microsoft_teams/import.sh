@@ -0,0 +1 @@
+terraform import taikun_notification_channel_microsoft_teams.myteams 42/1234
@@ -0,0 +1,10 @@
+resource "taikun_alerting_profile" "foo" {
+  name                        = "foo"
+  reminder                    = "Daily"
+  non_exclusive_sub_resources = true
+}
+
+resource "taikun_notification_channel_microsoft_teams" "foo" {
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+  url                 = "https://example.webhook.office.com/webhookb2/foo"
+}
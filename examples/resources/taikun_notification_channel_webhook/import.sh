@@ -0,0 +1 @@
+terraform import taikun_notification_channel_webhook.mywebhook 42/https://example.com/alerts
@@ -0,0 +1,15 @@
+resource "taikun_alerting_profile" "foo" {
+  name                        = "foo"
+  reminder                    = "Daily"
+  non_exclusive_sub_resources = true
+}
+
+resource "taikun_notification_channel_webhook" "foo" {
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+  url                 = "https://example.com/alerts"
+
+  header {
+    key   = "Authorization"
+    value = "Bearer foo"
+  }
+}
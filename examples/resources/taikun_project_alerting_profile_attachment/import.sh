@@ -0,0 +1 @@
+terraform import taikun_project_alerting_profile_attachment.myattachment 42/1234
@@ -0,0 +1,23 @@
+resource "taikun_alerting_profile" "foo" {
+  name     = "foo"
+  reminder = "Daily"
+}
+
+resource "taikun_cloud_credential_aws" "foo" {
+  name              = "foo"
+  availability_zone = "availability_zone"
+}
+
+resource "taikun_project" "foo" {
+  name                = "foo"
+  cloud_credential_id = resource.taikun_cloud_credential_aws.foo.id
+
+  lifecycle {
+    ignore_changes = [alerting_profile_id]
+  }
+}
+
+resource "taikun_project_alerting_profile_attachment" "foo" {
+  project_id          = resource.taikun_project.foo.id
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+}
@@ -0,0 +1,252 @@
+package taikun
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// addFilterArgumentsToDataSourceSchema adds the name_regex, filter, sort_by
+// and most_recent arguments to the schema of a plural data source,
+// listAttribute is the list holding its results and nameAttribute the
+// attribute of each result matched by name_regex
+func addFilterArgumentsToDataSourceSchema(dataSourceSchema map[string]*schema.Schema, listAttribute string, nameAttribute string) map[string]*schema.Schema {
+	elementSchema := dataSourceSchema[listAttribute].Elem.(*schema.Resource).Schema
+
+	var filterableAttributes []string
+	var sortableAttributes []string
+	for attribute, attributeSchema := range elementSchema {
+		switch attributeSchema.Type {
+		case schema.TypeBool, schema.TypeInt, schema.TypeFloat, schema.TypeString:
+			filterableAttributes = append(filterableAttributes, attribute)
+			sortableAttributes = append(sortableAttributes, attribute)
+		case schema.TypeList, schema.TypeSet:
+			if _, elemIsPrimitive := attributeSchema.Elem.(*schema.Schema); elemIsPrimitive {
+				filterableAttributes = append(filterableAttributes, attribute)
+			}
+		}
+	}
+	sort.Strings(filterableAttributes)
+	sort.Strings(sortableAttributes)
+
+	dataSourceSchema["filter"] = &schema.Schema{
+		Description: fmt.Sprintf("Only keep the %s whose attribute `name` has one of the given `values`, can be repeated to combine filters.", listAttribute),
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description:  "The attribute to filter on.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(filterableAttributes, false),
+				},
+				"values": {
+					Description: "The accepted values of the attribute, a list attribute matches if one of its elements is accepted.",
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+	dataSourceSchema["most_recent"] = &schema.Schema{
+		Description: fmt.Sprintf("Only keep the most recently created of the %s once filtered.", listAttribute),
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	dataSourceSchema["name_regex"] = &schema.Schema{
+		Description:  fmt.Sprintf("Only keep the %s whose `%s` matches the regular expression.", listAttribute, nameAttribute),
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+	dataSourceSchema["sort_by"] = &schema.Schema{
+		Description:  fmt.Sprintf("Sort the %s in ascending order of the given attribute.", listAttribute),
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(sortableAttributes, false),
+	}
+
+	return dataSourceSchema
+}
+
+type dataSourceAttributeFilter struct {
+	attribute string
+	values    map[string]struct{}
+}
+
+type dataSourceFilter struct {
+	attributeFilters []dataSourceAttributeFilter
+	mostRecent       bool
+	// Attribute whose highest value marks the most recent result
+	mostRecentAttribute string
+	nameAttribute       string
+	nameRegex           *regexp.Regexp
+	sortBy              string
+}
+
+// newDataSourceFilter reads the filter arguments added by
+// addFilterArgumentsToDataSourceSchema
+func newDataSourceFilter(d *schema.ResourceData, nameAttribute string) (*dataSourceFilter, error) {
+	// Data sources can delete most_recent and sort_by from their schema,
+	// e.g. when their results have no IDs or are already sorted
+	mostRecent, _ := d.Get("most_recent").(bool)
+	sortBy, _ := d.Get("sort_by").(string)
+	filter := dataSourceFilter{
+		mostRecent: mostRecent,
+		// IDs are allocated in increasing order, the most recent result has the highest one
+		mostRecentAttribute: "id",
+		nameAttribute:       nameAttribute,
+		sortBy:              sortBy,
+	}
+
+	if nameRegex, nameRegexIsSet := d.GetOk("name_regex"); nameRegexIsSet {
+		compiledNameRegex, err := regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return nil, err
+		}
+		filter.nameRegex = compiledNameRegex
+	}
+
+	for _, rawAttributeFilter := range d.Get("filter").([]interface{}) {
+		attributeFilterMap := rawAttributeFilter.(map[string]interface{})
+		attributeFilter := dataSourceAttributeFilter{
+			attribute: attributeFilterMap["name"].(string),
+			values:    map[string]struct{}{},
+		}
+		for _, value := range attributeFilterMap["values"].([]interface{}) {
+			attributeFilter.values[value.(string)] = struct{}{}
+		}
+		filter.attributeFilters = append(filter.attributeFilters, attributeFilter)
+	}
+
+	return &filter, nil
+}
+
+// search returns the string to send to the API's search parameter, the API
+// returns the results whose name contains it, nil if nothing can be pushed
+func (filter *dataSourceFilter) search() *string {
+	for _, attributeFilter := range filter.attributeFilters {
+		if attributeFilter.attribute == filter.nameAttribute && len(attributeFilter.values) == 1 {
+			for value := range attributeFilter.values {
+				return &value
+			}
+		}
+	}
+	if filter.nameRegex != nil {
+		if prefix, _ := filter.nameRegex.LiteralPrefix(); prefix != "" {
+			return &prefix
+		}
+	}
+	return nil
+}
+
+// apply returns the flattened results matching the filter, in the requested order
+func (filter *dataSourceFilter) apply(results []map[string]interface{}) []map[string]interface{} {
+	filtered := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		if filter.matches(result) {
+			filtered = append(filtered, result)
+		}
+	}
+
+	if filter.sortBy != "" {
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return dataSourceFilterValueIsLess(filtered[i][filter.sortBy], filtered[j][filter.sortBy])
+		})
+	}
+
+	if filter.mostRecent && len(filtered) > 1 {
+		mostRecent := filtered[0]
+		for _, result := range filtered[1:] {
+			if dataSourceFilterValueIsLess(mostRecent[filter.mostRecentAttribute], result[filter.mostRecentAttribute]) {
+				mostRecent = result
+			}
+		}
+		filtered = []map[string]interface{}{mostRecent}
+	}
+
+	return filtered
+}
+
+func (filter *dataSourceFilter) matches(result map[string]interface{}) bool {
+	if filter.nameRegex != nil {
+		name, _ := result[filter.nameAttribute].(string)
+		if !filter.nameRegex.MatchString(name) {
+			return false
+		}
+	}
+	for _, attributeFilter := range filter.attributeFilters {
+		if !attributeFilter.matches(result[attributeFilter.attribute]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (attributeFilter *dataSourceAttributeFilter) matches(value interface{}) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, element := range v {
+			if attributeFilter.matches(element) {
+				return true
+			}
+		}
+		return false
+	case []string:
+		for _, element := range v {
+			if attributeFilter.matches(element) {
+				return true
+			}
+		}
+		return false
+	case *schema.Set:
+		return attributeFilter.matches(v.List())
+	}
+	_, ok := attributeFilter.values[dataSourceFilterValueToString(value)]
+	return ok
+}
+
+func dataSourceFilterValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// dataSourceFilterValueIsLess compares values numerically when both are
+// numbers, IDs are flattened as strings, and lexicographically otherwise
+func dataSourceFilterValueIsLess(a interface{}, b interface{}) bool {
+	aString := dataSourceFilterValueToString(a)
+	bString := dataSourceFilterValueToString(b)
+	aNumber, aErr := strconv.ParseFloat(aString, 64)
+	bNumber, bErr := strconv.ParseFloat(bString, 64)
+	if aErr == nil && bErr == nil {
+		return aNumber < bNumber
+	}
+	return aString < bString
+}
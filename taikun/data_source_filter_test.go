@@ -0,0 +1,145 @@
+package taikun
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testDataSourceFilterResults() []map[string]interface{} {
+	return []map[string]interface{}{
+		{"id": "9", "name": "tf-acc-b", "lock": false, "tags": []string{"blue"}},
+		{"id": "10", "name": "tf-acc-a", "lock": true, "tags": []string{"red", "green"}},
+		{"id": "2", "name": "other", "lock": false, "tags": []string{"red"}},
+	}
+}
+
+func testDataSourceFilterSchema() map[string]*schema.Schema {
+	return addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
+		"results": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":   {Type: schema.TypeString, Computed: true},
+					"lock": {Type: schema.TypeBool, Computed: true},
+					"name": {Type: schema.TypeString, Computed: true},
+					"tags": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+			},
+		},
+	}, "results", "name")
+}
+
+func testDataSourceFilterIDs(results []map[string]interface{}) []string {
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result["id"].(string)
+	}
+	return ids
+}
+
+func TestDataSourceFilterApply(t *testing.T) {
+	testCases := []struct {
+		name       string
+		raw        map[string]interface{}
+		expected   []string
+		search     string
+		pushSearch bool
+	}{
+		{
+			name:     "no filter",
+			raw:      map[string]interface{}{},
+			expected: []string{"9", "10", "2"},
+		},
+		{
+			name:       "name regex",
+			raw:        map[string]interface{}{"name_regex": "^tf-acc-"},
+			expected:   []string{"9", "10"},
+			search:     "tf-acc-",
+			pushSearch: true,
+		},
+		{
+			name: "attribute filters",
+			raw: map[string]interface{}{
+				"filter": []interface{}{
+					map[string]interface{}{"name": "lock", "values": []interface{}{"false"}},
+					map[string]interface{}{"name": "tags", "values": []interface{}{"red", "yellow"}},
+				},
+			},
+			expected: []string{"2"},
+		},
+		{
+			name: "name filter",
+			raw: map[string]interface{}{
+				"filter": []interface{}{
+					map[string]interface{}{"name": "name", "values": []interface{}{"other"}},
+				},
+			},
+			expected:   []string{"2"},
+			search:     "other",
+			pushSearch: true,
+		},
+		{
+			name:     "sort by numeric ID",
+			raw:      map[string]interface{}{"sort_by": "id"},
+			expected: []string{"2", "9", "10"},
+		},
+		{
+			name:     "sort by name",
+			raw:      map[string]interface{}{"sort_by": "name"},
+			expected: []string{"2", "10", "9"},
+		},
+		{
+			name:       "most recent",
+			raw:        map[string]interface{}{"name_regex": "tf-acc", "most_recent": true},
+			expected:   []string{"10"},
+			search:     "tf-acc",
+			pushSearch: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, testDataSourceFilterSchema(), testCase.raw)
+			filter, err := newDataSourceFilter(d, "name")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := testDataSourceFilterIDs(filter.apply(testDataSourceFilterResults()))
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+
+			search := filter.search()
+			if (search != nil) != testCase.pushSearch || (search != nil && *search != testCase.search) {
+				t.Errorf("unexpected search %v", search)
+			}
+		})
+	}
+}
+
+func TestDataSourceFilterSchemaOnlyAcceptsPrimitiveAttributes(t *testing.T) {
+	dataSourceSchema := testDataSourceFilterSchema()
+	dataSourceSchema["results"].Elem.(*schema.Resource).Schema["nested"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+	}
+	dataSourceSchema = addFilterArgumentsToDataSourceSchema(dataSourceSchema, "results", "name")
+
+	filterNameSchema := dataSourceSchema["filter"].Elem.(*schema.Resource).Schema["name"]
+	for name, valid := range map[string]bool{"tags": true, "name": true, "nested": false, "unknown": false} {
+		_, errs := filterNameSchema.ValidateFunc(name, "name")
+		if (len(errs) == 0) != valid {
+			t.Errorf("filtering on %s: expected valid=%t, got errors %v", name, valid, errs)
+		}
+	}
+
+	_, errs := dataSourceSchema["sort_by"].ValidateFunc("tags", "sort_by")
+	if len(errs) == 0 {
+		t.Error("sorting on a list attribute should be rejected")
+	}
+}
@@ -0,0 +1,281 @@
+package taikun
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+const (
+	imageArchitectureARM64 = "arm64"
+	imageArchitectureX8664 = "x86_64"
+)
+
+type imageOSFamily struct {
+	name    string
+	pattern *regexp.Regexp
+	// Returns the version of the OS found in the lowercased image text, or ""
+	version func(text string) string
+}
+
+// imageVersionAfter returns a function finding the first version number,
+// e.g. 9 or 8.6, following the OS family keyword
+func imageVersionAfter(keyword string) func(string) string {
+	versionRegexp := regexp.MustCompile(fmt.Sprintf(`(?:%s)[a-z :_-]*?([0-9]+(?:[._][0-9]+)?)`, keyword))
+	return func(text string) string {
+		if match := versionRegexp.FindStringSubmatch(text); match != nil {
+			return strings.ReplaceAll(match[1], "_", ".")
+		}
+		return ""
+	}
+}
+
+// imageVersionFromCodename returns a function finding the version of an OS
+// from a pattern or, failing that, from its release codename
+func imageVersionFromCodename(versionRegexp *regexp.Regexp, codenames map[string]string) func(string) string {
+	return func(text string) string {
+		if match := versionRegexp.FindStringSubmatch(text); match != nil {
+			return strings.Join(match[1:], ".")
+		}
+		for codename, version := range codenames {
+			if strings.Contains(text, codename) {
+				return version
+			}
+		}
+		return ""
+	}
+}
+
+// The OS families are matched in order, e.g. Windows images can mention Ubuntu
+var imageOSFamilies = []imageOSFamily{
+	{
+		name:    "windows",
+		pattern: regexp.MustCompile(`windows`),
+		version: imageVersionFromCodename(regexp.MustCompile(`(?:server|windows)[ :_-]*(20[0-9]{2}|1[01])(?:[^0-9]|$)`), nil),
+	},
+	{
+		name:    "ubuntu",
+		pattern: regexp.MustCompile(`ubuntu`),
+		version: imageVersionFromCodename(regexp.MustCompile(`(?:^|[^0-9])(1[4-9]|2[0-9])[._]?(04|10)(?:[^0-9]|$)`), map[string]string{
+			"xenial":  "16.04",
+			"bionic":  "18.04",
+			"focal":   "20.04",
+			"jammy":   "22.04",
+			"kinetic": "22.10",
+			"lunar":   "23.04",
+			"mantic":  "23.10",
+			"noble":   "24.04",
+		}),
+	},
+	{
+		name:    "debian",
+		pattern: regexp.MustCompile(`debian`),
+		version: imageVersionFromCodename(regexp.MustCompile(`debian[ :_-]*([0-9]{1,2})(?:[^0-9]|$)`), map[string]string{
+			"stretch":  "9",
+			"buster":   "10",
+			"bullseye": "11",
+			"bookworm": "12",
+		}),
+	},
+	{
+		name:    "rhel",
+		pattern: regexp.MustCompile(`rhel|red ?hat`),
+		version: imageVersionAfter(`rhel|red ?hat enterprise linux`),
+	},
+	{
+		name:    "rocky",
+		pattern: regexp.MustCompile(`rocky`),
+		version: imageVersionAfter(`rocky`),
+	},
+	{
+		name:    "almalinux",
+		pattern: regexp.MustCompile(`alma`),
+		version: imageVersionAfter(`alma`),
+	},
+	{
+		name:    "centos",
+		pattern: regexp.MustCompile(`centos`),
+		version: imageVersionAfter(`centos`),
+	},
+	{
+		name:    "fedora",
+		pattern: regexp.MustCompile(`fedora`),
+		version: imageVersionAfter(`fedora`),
+	},
+	{
+		name:    "suse",
+		pattern: regexp.MustCompile(`suse|sles`),
+		version: imageVersionAfter(`suse|sles`),
+	},
+	{
+		name:    "amazon",
+		pattern: regexp.MustCompile(`amzn|amazon linux|al20[0-9]{2}`),
+		version: imageVersionFromCodename(regexp.MustCompile(`(?:amzn|amazon linux ?|al)(20[0-9]{2}|2)(?:[^0-9]|$)`), nil),
+	},
+	{
+		name:    "flatcar",
+		pattern: regexp.MustCompile(`flatcar`),
+		version: func(string) string { return "" },
+	},
+}
+
+var imageBuildDateRegexp = regexp.MustCompile(`(20[0-9]{2})(0[1-9]|1[0-2])(0[1-9]|[12][0-9]|3[01])`)
+var imageARM64Regexp = regexp.MustCompile(`arm64|aarch64`)
+
+func imageOSFamilyNames() []string {
+	names := make([]string, len(imageOSFamilies))
+	for i, family := range imageOSFamilies {
+		names[i] = family.name
+	}
+	return names
+}
+
+// flattenTaikunImageOS returns the OS attributes of an image, derived from
+// the given texts describing it, e.g. its name and ID, in order of relevance
+func flattenTaikunImageOS(texts ...string) map[string]interface{} {
+	text := strings.ToLower(strings.Join(texts, " "))
+
+	var osFamily string
+	var osVersion string
+	for _, family := range imageOSFamilies {
+		if family.pattern.MatchString(text) {
+			osFamily = family.name
+			osVersion = family.version(text)
+			break
+		}
+	}
+
+	architecture := imageArchitectureX8664
+	if imageARM64Regexp.MatchString(text) {
+		architecture = imageArchitectureARM64
+	}
+
+	var buildDate string
+	for _, t := range texts {
+		if matches := imageBuildDateRegexp.FindAllStringSubmatch(t, -1); matches != nil {
+			lastMatch := matches[len(matches)-1]
+			buildDate = strings.Join(lastMatch[1:], "-")
+			break
+		}
+	}
+
+	return map[string]interface{}{
+		"architecture": architecture,
+		"build_date":   buildDate,
+		"os_family":    osFamily,
+		"os_version":   osVersion,
+	}
+}
+
+// addImageFilterArgumentsToDataSourceSchema adds the OS attributes to the
+// images of an image data source and the arguments filtering them
+func addImageFilterArgumentsToDataSourceSchema(dataSourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	imageSchema := dataSourceSchema["images"].Elem.(*schema.Resource).Schema
+	imageSchema["architecture"] = &schema.Schema{
+		Description: "CPU architecture of the image: `arm64` or `x86_64`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	imageSchema["build_date"] = &schema.Schema{
+		Description: "Build date of the image in the YYYY-MM-DD format, empty if it cannot be found in the image's name or ID.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	imageSchema["os_family"] = &schema.Schema{
+		Description: fmt.Sprintf("OS family of the image, empty if unknown: %s.", strings.Join(imageOSFamilyNames(), ", ")),
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	imageSchema["os_version"] = &schema.Schema{
+		Description: "OS version of the image, e.g. `22.04` for Ubuntu, `12` for Debian or `2022` for Windows, empty if unknown.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	dataSourceSchema["architecture"] = &schema.Schema{
+		Description:  "Only keep the images of the given CPU architecture: `arm64` or `x86_64`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{imageArchitectureARM64, imageArchitectureX8664}, false),
+	}
+	dataSourceSchema["os_family"] = &schema.Schema{
+		Description:  "Only keep the images of the given OS family.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(imageOSFamilyNames(), false),
+	}
+	dataSourceSchema["os_version"] = &schema.Schema{
+		Description:  "Only keep the images of the given OS version or of its minor versions, e.g. `9` matches `9.1`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	addFilterArgumentsToDataSourceSchema(dataSourceSchema, "images", "name")
+	dataSourceSchema["most_recent"].Description = "Only keep the image with the latest build date once filtered."
+
+	return dataSourceSchema
+}
+
+type imageFilter struct {
+	*dataSourceFilter
+	architecture string
+	osFamily     string
+	osVersion    string
+}
+
+// newImageFilter reads the arguments added by addImageFilterArgumentsToDataSourceSchema
+func newImageFilter(d *schema.ResourceData) (*imageFilter, error) {
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return nil, err
+	}
+	filter.mostRecentAttribute = "build_date"
+
+	return &imageFilter{
+		dataSourceFilter: filter,
+		architecture:     d.Get("architecture").(string),
+		osFamily:         d.Get("os_family").(string),
+		osVersion:        d.Get("os_version").(string),
+	}, nil
+}
+
+func (filter *imageFilter) apply(images []map[string]interface{}) []map[string]interface{} {
+	filtered := make([]map[string]interface{}, 0, len(images))
+	for _, image := range images {
+		if filter.architecture != "" && image["architecture"] != filter.architecture {
+			continue
+		}
+		if filter.osFamily != "" && image["os_family"] != filter.osFamily {
+			continue
+		}
+		if filter.osVersion != "" {
+			osVersion := image["os_version"].(string)
+			if osVersion != filter.osVersion && !strings.HasPrefix(osVersion, filter.osVersion+".") {
+				continue
+			}
+		}
+		filtered = append(filtered, image)
+	}
+	return filter.dataSourceFilter.apply(filtered)
+}
+
+// withImageOS adds the OS attributes derived from the texts to the flattened image
+func withImageOS(image map[string]interface{}, texts ...string) map[string]interface{} {
+	for key, value := range flattenTaikunImageOS(texts...) {
+		image[key] = value
+	}
+	return image
+}
+
+func flattenTaikunImagesWithOS(rawImages ...*models.CommonStringBasedDropdownDto) []map[string]interface{} {
+	images := flattenTaikunImages(rawImages...)
+	for i, rawImage := range rawImages {
+		withImageOS(images[i], rawImage.Name, rawImage.ID)
+	}
+	return images
+}
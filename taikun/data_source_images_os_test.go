@@ -0,0 +1,116 @@
+package taikun
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenTaikunImageOS(t *testing.T) {
+	testCases := []struct {
+		texts    []string
+		expected map[string]interface{}
+	}{
+		{
+			texts: []string{"ubuntu/images/hvm-ssd/ubuntu-jammy-22.04-amd64-server-20230115", "Canonical, Ubuntu, 22.04 LTS, amd64 jammy image build on 2023-01-15"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "2023-01-15", "os_family": "ubuntu", "os_version": "22.04",
+			},
+		},
+		{
+			texts: []string{"ubuntu-2004-focal-arm64-v20230114"},
+			expected: map[string]interface{}{
+				"architecture": "arm64", "build_date": "2023-01-14", "os_family": "ubuntu", "os_version": "20.04",
+			},
+		},
+		{
+			texts: []string{"22.04.202301140", "Canonical:0001-com-ubuntu-server-jammy:22_04-lts-gen2:22.04.202301140"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "2023-01-14", "os_family": "ubuntu", "os_version": "22.04",
+			},
+		},
+		{
+			texts: []string{"Ubuntu Jammy"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "", "os_family": "ubuntu", "os_version": "22.04",
+			},
+		},
+		{
+			texts: []string{"debian-11-bullseye-v20230206"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "2023-02-06", "os_family": "debian", "os_version": "11",
+			},
+		},
+		{
+			texts: []string{"RHEL-9.1.0_HVM-20221101-arm64-2-Hourly2-GP2", "Red Hat Enterprise Linux"},
+			expected: map[string]interface{}{
+				"architecture": "arm64", "build_date": "2022-11-01", "os_family": "rhel", "os_version": "9.1",
+			},
+		},
+		{
+			texts: []string{"8.6.2022060701", "RedHat:RHEL:8_6:8.6.2022060701"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "2022-06-07", "os_family": "rhel", "os_version": "8.6",
+			},
+		},
+		{
+			texts: []string{"Windows_Server-2019-English-Full-Base-2023.01.11", "Microsoft Windows Server 2019 with Desktop Experience Locale English AMI provided by Amazon", "Windows"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "", "os_family": "windows", "os_version": "2019",
+			},
+		},
+		{
+			texts: []string{"amzn2-ami-kernel-5.10-hvm-2.0.20230119.1-x86_64-gp2"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "2023-01-19", "os_family": "amazon", "os_version": "2",
+			},
+		},
+		{
+			texts: []string{"Rocky-9-GenericCloud-Base-9.1-20221130.0.x86_64"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "2022-11-30", "os_family": "rocky", "os_version": "9",
+			},
+		},
+		{
+			texts: []string{"my-custom-image"},
+			expected: map[string]interface{}{
+				"architecture": "x86_64", "build_date": "", "os_family": "", "os_version": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		if actual := flattenTaikunImageOS(testCase.texts...); !reflect.DeepEqual(actual, testCase.expected) {
+			t.Errorf("%v: expected %v, got %v", testCase.texts, testCase.expected, actual)
+		}
+	}
+}
+
+func TestImageFilterApply(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceTaikunImagesOpenStack().Schema, map[string]interface{}{
+		"cloud_credential_id": "42",
+		"os_family":           "rocky",
+		"os_version":          "9",
+		"most_recent":         true,
+	})
+	filter, err := newImageFilter(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var images []map[string]interface{}
+	for _, name := range []string{
+		"Rocky-9-GenericCloud-Base-9.1-20221130.0.x86_64",
+		"Rocky-9-GenericCloud-Base-9.2-20230513.0.x86_64",
+		"Rocky-8-GenericCloud-Base-8.8-20230518.0.x86_64",
+		"ubuntu-22.04-server-cloudimg-amd64-20230601",
+	} {
+		images = append(images, withImageOS(map[string]interface{}{"id": name, "name": name}, name))
+	}
+
+	filtered := filter.apply(images)
+	if len(filtered) != 1 || filtered[0]["name"] != "Rocky-9-GenericCloud-Base-9.2-20230513.0.x86_64" {
+		t.Fatalf("expected the latest Rocky 9 image, got %v", filtered)
+	}
+}
@@ -0,0 +1,87 @@
+package taikun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/openstack"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+// The OpenStack discovery endpoints take the credentials themselves, the API
+// does not return the secrets of existing cloud credentials
+var openStackCredentialsArguments = []string{
+	"application_credential_id",
+	"application_credential_secret",
+	"domain",
+	"password",
+	"project_name",
+	"region",
+	"url",
+	"user",
+}
+
+// addOpenStackCredentialsArgumentsToDataSourceSchema adds the OpenStack
+// credentials arguments of taikun_cloud_credential_openstack, with the same
+// environment variable defaults, to the schema of a data source, whose read
+// must get them with dataSourceTaikunOpenStackGetAuth
+func addOpenStackCredentialsArgumentsToDataSourceSchema(dataSourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	resourceSchema := resourceTaikunCloudCredentialOpenStackSchema()
+	for _, key := range openStackCredentialsArguments {
+		argumentSchema := resourceSchema[key]
+		argumentSchema.ForceNew = false
+		dataSourceSchema[key] = argumentSchema
+	}
+	return dataSourceSchema
+}
+
+// dataSourceTaikunOpenStackGetAuth returns the user and password sent to
+// Taikun, once the authentication method is validated
+func dataSourceTaikunOpenStackGetAuth(d *schema.ResourceData) (user string, password string, applicationCredentialEnabled bool, err error) {
+	if err := resourceTaikunCloudCredentialOpenStackValidateAuth(d.GetRawConfig(), d.Get); err != nil {
+		return "", "", false, err
+	}
+	user, password, applicationCredentialEnabled = resourceTaikunCloudCredentialOpenStackGetAuth(d.Get)
+	return user, password, applicationCredentialEnabled, nil
+}
+
+// dataSourceTaikunOpenStackGetProjectID returns the ID of the OpenStack
+// project named by project_name
+func dataSourceTaikunOpenStackGetProjectID(d *schema.ResourceData, apiClient *taikungoclient.Client) (string, error) {
+	user, password, applicationCredentialEnabled, err := dataSourceTaikunOpenStackGetAuth(d)
+	if err != nil {
+		return "", err
+	}
+	body := &models.OpenStackProjectListQuery{
+		ApplicationCredEnabled: applicationCredentialEnabled,
+		OpenStackDomain:        d.Get("domain").(string),
+		OpenStackPassword:      password,
+		OpenStackURL:           d.Get("url").(string),
+		OpenStackUser:          user,
+	}
+	params := openstack.NewOpenstackProjectsParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.Openstack.OpenstackProjects(params, apiClient)
+	if err != nil {
+		return "", err
+	}
+
+	projectName := d.Get("project_name").(string)
+	for _, project := range response.Payload {
+		if project.Name == projectName {
+			return project.ID, nil
+		}
+	}
+	return "", fmt.Errorf("OpenStack project %q not found", projectName)
+}
+
+func flattenTaikunOpenStackDropdown(rawItems []*models.CommonStringBasedDropdownDto) []map[string]interface{} {
+	items := make([]map[string]interface{}, len(rawItems))
+	for i, rawItem := range rawItems {
+		items[i] = map[string]interface{}{
+			"id":   rawItem.ID,
+			"name": rawItem.Name,
+		}
+	}
+	return items
+}
@@ -9,6 +9,7 @@ import (
 
 func dataSourceTaikunAccessProfileSchema() map[string]*schema.Schema {
 	dsSchema := dataSourceSchemaFromResourceSchema(resourceTaikunAccessProfileSchema())
+	deleteFieldsFromSchema(dsSchema, "non_exclusive_sub_resources")
 	addRequiredFieldsToSchema(dsSchema, "id")
 	setValidateDiagFuncToSchema(dsSchema, "id", stringIsInt)
 	return dsSchema
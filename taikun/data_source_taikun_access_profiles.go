@@ -16,7 +16,7 @@ func dataSourceTaikunAccessProfiles() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all access profiles.",
 		ReadContext: dataSourceTaikunAccessProfilesRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"access_profiles": {
 				Description: "List of retrieved access profiles.",
 				Type:        schema.TypeList,
@@ -31,7 +31,7 @@ func dataSourceTaikunAccessProfiles() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "access_profiles", "name"),
 	}
 }
 
@@ -41,6 +41,12 @@ func dataSourceTaikunAccessProfilesRead(_ context.Context, d *schema.ResourceDat
 
 	params := access_profiles.NewAccessProfilesListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -76,6 +82,8 @@ func dataSourceTaikunAccessProfilesRead(_ context.Context, d *schema.ResourceDat
 
 		accessProfiles[i] = flattenTaikunAccessProfile(rawAccessProfile, sshResponse)
 	}
+	accessProfiles = filter.apply(accessProfiles)
+
 	if err := d.Set("access_profiles", accessProfiles); err != nil {
 		return diag.FromErr(err)
 	}
@@ -74,3 +74,49 @@ func TestAccDataSourceTaikunAccessProfilesWithFilter(t *testing.T) {
 		},
 	})
 }
+
+const testAccDataSourceTaikunAccessProfilesWithNameRegexConfig = `
+resource "taikun_access_profile" "foo" {
+  name = "%s"
+}
+
+resource "taikun_access_profile" "bar" {
+  name = "%s"
+}
+
+data "taikun_access_profiles" "by_regex" {
+  name_regex = "^${taikun_access_profile.foo.name}$"
+
+  depends_on = [
+    taikun_access_profile.bar
+  ]
+}
+
+data "taikun_access_profiles" "most_recent" {
+  filter {
+    name   = "name"
+    values = [taikun_access_profile.foo.name, taikun_access_profile.bar.name]
+  }
+  sort_by     = "name"
+  most_recent = true
+}`
+
+func TestAccDataSourceTaikunAccessProfilesWithNameRegex(t *testing.T) {
+	accessProfileName := randomTestName()
+	otherAccessProfileName := randomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunAccessProfilesWithNameRegexConfig, accessProfileName, otherAccessProfileName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_access_profiles.by_regex", "access_profiles.#", "1"),
+					resource.TestCheckResourceAttr("data.taikun_access_profiles.by_regex", "access_profiles.0.name", accessProfileName),
+					resource.TestCheckResourceAttr("data.taikun_access_profiles.most_recent", "access_profiles.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 func dataSourceTaikunAlertingProfileSchema() map[string]*schema.Schema {
 	alertingProfileSchema := dataSourceSchemaFromResourceSchema(resourceTaikunAlertingProfileSchema())
+	deleteFieldsFromSchema(alertingProfileSchema, "non_exclusive_sub_resources")
 	addRequiredFieldsToSchema(alertingProfileSchema, "id")
 	setValidateDiagFuncToSchema(alertingProfileSchema, "id", stringIsInt)
 	return alertingProfileSchema
@@ -16,7 +16,7 @@ func dataSourceTaikunAlertingProfiles() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all alerting profiles.",
 		ReadContext: dataSourceTaikunAlertingProfilesRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"alerting_profiles": {
 				Description: "List of retrieved alerting profiles.",
 				Type:        schema.TypeList,
@@ -31,7 +31,7 @@ func dataSourceTaikunAlertingProfiles() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "alerting_profiles", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunAlertingProfilesRead(_ context.Context, d *schema.ResourceD
 	dataSourceID := "all"
 
 	params := alerting_profiles.NewAlertingProfilesListParams().WithV(ApiVersion)
+
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
 	if organizationIDData, organizationIDProvided := d.GetOk("organization_id"); organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
 		organizationID, err := atoi32(dataSourceID)
@@ -75,6 +81,8 @@ func dataSourceTaikunAlertingProfilesRead(_ context.Context, d *schema.ResourceD
 		alertingProfiles[i] = flattenTaikunAlertingProfile(alertingProfileDTO, alertingIntegrationsResponse.Payload)
 	}
 
+	alertingProfiles = filter.apply(alertingProfiles)
+
 	if err := d.Set("alerting_profiles", alertingProfiles); err != nil {
 		return diag.FromErr(err)
 	}
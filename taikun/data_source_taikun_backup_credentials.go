@@ -15,7 +15,7 @@ func dataSourceTaikunBackupCredentials() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all backup credentials.",
 		ReadContext: dataSourceTaikunBackupCredentialsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"backup_credentials": {
 				Description: "List of retrieved backup credentials.",
 				Type:        schema.TypeList,
@@ -30,7 +30,7 @@ func dataSourceTaikunBackupCredentials() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "backup_credentials", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunBackupCredentialsRead(_ context.Context, d *schema.Resource
 
 	params := s3_credentials.NewS3CredentialsListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -68,6 +74,8 @@ func dataSourceTaikunBackupCredentialsRead(_ context.Context, d *schema.Resource
 	for i, rawBackupCredential := range backupCredentialsList {
 		backupCredentials[i] = flattenTaikunBackupCredential(rawBackupCredential)
 	}
+	backupCredentials = filter.apply(backupCredentials)
+
 	if err := d.Set("backup_credentials", backupCredentials); err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,7 @@ func dataSourceTaikunBillingCredentials() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all billing credentials.",
 		ReadContext: dataSourceTaikunBillingCredentialsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"billing_credentials": {
 				Description: "List of retrieved billing credentials.",
 				Type:        schema.TypeList,
@@ -29,7 +29,7 @@ func dataSourceTaikunBillingCredentials() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "billing_credentials", "name"),
 	}
 }
 
@@ -39,6 +39,12 @@ func dataSourceTaikunBillingCredentialsRead(_ context.Context, d *schema.Resourc
 
 	params := ops_credentials.NewOpsCredentialsListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -67,6 +73,8 @@ func dataSourceTaikunBillingCredentialsRead(_ context.Context, d *schema.Resourc
 	for i, rawOperationCredential := range operationCredentialsList {
 		operationCredentials[i] = flattenTaikunBillingCredential(rawOperationCredential)
 	}
+	operationCredentials = filter.apply(operationCredentials)
+
 	if err := d.Set("billing_credentials", operationCredentials); err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,7 @@ func dataSourceTaikunBillingRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all billing rules.",
 		ReadContext: dataSourceTaikunBillingRulesRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"billing_rules": {
 				Description: "List of retrieved billing rules.",
 				Type:        schema.TypeList,
@@ -23,7 +23,7 @@ func dataSourceTaikunBillingRules() *schema.Resource {
 					Schema: dataSourceTaikunBillingRuleSchema(),
 				},
 			},
-		},
+		}, "billing_rules", "name"),
 	}
 }
 
@@ -32,6 +32,12 @@ func dataSourceTaikunBillingRulesRead(_ context.Context, d *schema.ResourceData,
 
 	params := prometheus.NewPrometheusListOfRulesParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	var billingRulesList []*models.PrometheusRuleListDto
 	for {
 		response, err := apiClient.Client.Prometheus.PrometheusListOfRules(params, apiClient)
@@ -50,6 +56,8 @@ func dataSourceTaikunBillingRulesRead(_ context.Context, d *schema.ResourceData,
 	for i, rawBillingRule := range billingRulesList {
 		billingRules[i] = flattenTaikunBillingRule(rawBillingRule)
 	}
+	billingRules = filter.apply(billingRules)
+
 	if err := d.Set("billing_rules", billingRules); err != nil {
 		return diag.FromErr(err)
 	}
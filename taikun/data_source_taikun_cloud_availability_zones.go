@@ -0,0 +1,73 @@
+package taikun
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/itera-io/taikungoclient"
+)
+
+func dataSourceTaikunCloudAvailabilityZones() *schema.Resource {
+	availabilityZonesSchema := dataSourceTaikunCloudLocationSchema()
+	availabilityZonesSchema["availability_zones"] = &schema.Schema{
+		Description: "List of available availability zones, for AWS only the zone's suffix is returned, e.g. `a` for `eu-central-1a`. Only holds the cloud credential's availability zone, if any, if `cloud_credential_id` is set.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	availabilityZonesSchema["region"] = &schema.Schema{
+		Description:   "The region, or location for Azure, whose availability zones are listed. Required unless `cloud_credential_id` is set.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.StringIsNotEmpty,
+		ConflictsWith: []string{"cloud_credential_id"},
+	}
+
+	return &schema.Resource{
+		Description: "Retrieve the availability zones of a region available with the given cloud credentials.",
+		ReadContext: dataSourceTaikunCloudAvailabilityZonesRead,
+		Schema:      availabilityZonesSchema,
+	}
+}
+
+func dataSourceTaikunCloudAvailabilityZonesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	var availabilityZones []string
+	cloudType, cloudCredential, err := dataSourceTaikunCloudLocationGetCloudCredential(d, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cloudCredential != nil {
+		availabilityZones = []string{}
+		if zone := cloudCredential[cloudCredentialLocations[cloudType].zone].(string); zone != "" {
+			availabilityZones = append(availabilityZones, zone)
+		}
+		d.SetId(d.Get("cloud_credential_id").(string))
+	} else {
+		region := d.Get("region").(string)
+		if region == "" {
+			return diag.Errorf("region must be set to list the availability zones with cloud credentials")
+		}
+		var get cloudCredentialGetter
+		cloudType, get = dataSourceTaikunCloudLocationGetCredentials(d)
+		availabilityZones, err = cloudCredentialListAvailabilityZones(cloudType, get, region, apiClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(cloudType + "-" + region)
+	}
+
+	if err := d.Set("cloud_type", cloudType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("availability_zones", availabilityZones); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
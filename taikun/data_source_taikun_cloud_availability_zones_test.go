@@ -0,0 +1,34 @@
+package taikun
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDataSourceTaikunCloudAvailabilityZonesAWSConfig = `
+data "taikun_cloud_availability_zones" "foo" {
+  aws {}
+  region = "%s"
+}
+`
+
+func TestAccDataSourceTaikunCloudAvailabilityZonesAWS(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWS(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunCloudAvailabilityZonesAWSConfig,
+					os.Getenv("AWS_DEFAULT_REGION"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_cloud_availability_zones.foo", "cloud_type", "AWS"),
+					resource.TestCheckTypeSetElemAttr("data.taikun_cloud_availability_zones.foo", "availability_zones.*", os.Getenv("AWS_AVAILABILITY_ZONE")),
+				),
+			},
+		},
+	})
+}
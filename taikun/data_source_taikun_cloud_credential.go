@@ -0,0 +1,180 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/cloud_credentials"
+)
+
+// Fields shared by the cloud credentials of all cloud types,
+// the other fields are set in the block of the cloud credential's type
+var cloudCredentialCommonFields = []string{
+	"created_by",
+	"id",
+	"is_default",
+	"last_modified",
+	"last_modified_by",
+	"lock",
+	"name",
+	"organization_id",
+	"organization_name",
+}
+
+// Name of the block holding the cloud specific fields of each cloud type
+var cloudCredentialBlocks = map[string]string{
+	cloudTypeAWS:       "aws",
+	cloudTypeAzure:     "azure",
+	cloudTypeGCP:       "gcp",
+	cloudTypeOpenStack: "openstack",
+}
+
+func dataSourceTaikunCloudCredentialBlockSchema(cloudType string, cloudSchema map[string]*schema.Schema) *schema.Schema {
+	deleteFieldsFromSchema(cloudSchema, cloudCredentialCommonFields...)
+	return &schema.Schema{
+		Description: fmt.Sprintf("The %s specific fields, only set if the cloud credential's type is %s.", cloudType, cloudType),
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: cloudSchema,
+		},
+	}
+}
+
+func dataSourceTaikunCloudCredentialSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"aws":   dataSourceTaikunCloudCredentialBlockSchema(cloudTypeAWS, dataSourceTaikunCloudCredentialAWSSchema()),
+		"azure": dataSourceTaikunCloudCredentialBlockSchema(cloudTypeAzure, dataSourceTaikunCloudCredentialAzureSchema()),
+		"cloud_type": {
+			Description: "The type of the cloud credential: `AWS`, `Azure`, `GCP` or `OpenStack`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_by": {
+			Description: "The creator of the cloud credential.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"gcp": dataSourceTaikunCloudCredentialBlockSchema(cloudTypeGCP, dataSourceTaikunCloudCredentialGCPSchema()),
+		"id": {
+			Description:      "The ID of the cloud credential.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: stringIsInt,
+			ExactlyOneOf:     []string{"id", "name"},
+		},
+		"is_default": {
+			Description: "Indicates whether the cloud credential is the default one.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"last_modified": {
+			Description: "Time and date of last modification.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_modified_by": {
+			Description: "The last user to have modified the cloud credential.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"lock": {
+			Description: "Indicates whether the cloud credential is locked.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"name": {
+			Description:  "The name of the cloud credential.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"id", "name"},
+		},
+		"openstack": dataSourceTaikunCloudCredentialBlockSchema(cloudTypeOpenStack, dataSourceTaikunCloudCredentialOpenStackSchema()),
+		"organization_id": {
+			Description:      "The ID of the organization which owns the cloud credential, can be specified to look up a cloud credential by name.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: stringIsInt,
+		},
+		"organization_name": {
+			Description: "The name of the organization which owns the cloud credential.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceTaikunCloudCredential() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get a cloud credential of any cloud type by its ID or name.",
+		ReadContext: dataSourceTaikunCloudCredentialRead,
+		Schema:      dataSourceTaikunCloudCredentialSchema(),
+	}
+}
+
+func dataSourceTaikunCloudCredentialRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	params := cloud_credentials.NewCloudCredentialsDashboardListParams().WithV(ApiVersion)
+
+	idData, idIsSet := d.GetOk("id")
+	if idIsSet {
+		id, err := atoi32(idData.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		params = params.WithID(&id)
+	}
+
+	name := d.Get("name").(string)
+	if !idIsSet {
+		params = params.WithSearch(&name)
+		if organizationIDData, organizationIDIsSet := d.GetOk("organization_id"); organizationIDIsSet {
+			organizationID, err := atoi32(organizationIDData.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			params = params.WithOrganizationID(&organizationID)
+		}
+	}
+
+	cloudCredentials, err := dataSourceTaikunCloudCredentialsList(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Search also returns cloud credentials whose name contains the one looked up
+	if !idIsSet {
+		matches := make([]map[string]interface{}, 0)
+		for _, cloudCredential := range cloudCredentials {
+			if cloudCredential["name"] == name {
+				matches = append(matches, cloudCredential)
+			}
+		}
+		cloudCredentials = matches
+	}
+
+	if len(cloudCredentials) == 0 {
+		if idIsSet {
+			return diag.Errorf("cloud credential with ID %s not found", idData.(string))
+		}
+		return diag.Errorf("cloud credential with name %s not found", name)
+	}
+	if len(cloudCredentials) > 1 {
+		return diag.Errorf("%d cloud credentials are named %s, specify organization_id or id", len(cloudCredentials), name)
+	}
+
+	if err := setResourceDataFromMap(d, cloudCredentials[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(cloudCredentials[0]["id"].(string))
+
+	return nil
+}
@@ -40,6 +40,7 @@ func TestAccDataSourceTaikunCloudCredentialAWS(t *testing.T) {
 					map[string]struct{}{
 						"access_key_id":     {},
 						"secret_access_key": {},
+						"validate":          {},
 					},
 				),
 			},
@@ -11,7 +11,7 @@ func dataSourceTaikunCloudCredentialAzureSchema() map[string]*schema.Schema {
 	dsSchema := dataSourceSchemaFromResourceSchema(resourceTaikunCloudCredentialAzureSchema())
 	addRequiredFieldsToSchema(dsSchema, "id")
 	setValidateDiagFuncToSchema(dsSchema, "id", stringIsInt)
-	deleteFieldsFromSchema(dsSchema, "subscription_id", "client_id", "client_secret")
+	deleteFieldsFromSchema(dsSchema, "subscription_id", "client_id", "client_secret", "rotation_trigger", "validate")
 	return dsSchema
 }
 
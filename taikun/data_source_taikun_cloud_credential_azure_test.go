@@ -43,6 +43,7 @@ func TestAccDataSourceTaikunCloudCredentialAzure(t *testing.T) {
 						"client_id":       {},
 						"subscription_id": {},
 						"client_secret":   {},
+						"validate":        {},
 					},
 				),
 			},
@@ -13,8 +13,8 @@ func dataSourceTaikunCloudCredentialGCPSchema() map[string]*schema.Schema {
 	setValidateDiagFuncToSchema(dsSchema, "id", stringIsInt)
 
 	// config_file & import_project only make sense when declaring a resource
-	deleteFieldsFromSchema(dsSchema, "config_file")
-	deleteFieldsFromSchema(dsSchema, "import_project")
+	deleteFieldsFromSchema(dsSchema, "config_file", "config_json")
+	deleteFieldsFromSchema(dsSchema, "import_project", "validate")
 
 	return dsSchema
 }
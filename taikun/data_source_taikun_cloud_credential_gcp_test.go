@@ -42,6 +42,7 @@ func TestAccDataSourceTaikunCloudCredentialGCP(t *testing.T) {
 					map[string]struct{}{
 						"config_file":    {},
 						"import_project": {},
+						"validate":       {},
 					},
 				),
 			},
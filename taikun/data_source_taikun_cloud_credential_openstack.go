@@ -11,7 +11,7 @@ func dataSourceTaikunCloudCredentialOpenStackSchema() map[string]*schema.Schema
 	dsSchema := dataSourceSchemaFromResourceSchema(resourceTaikunCloudCredentialOpenStackSchema())
 	addRequiredFieldsToSchema(dsSchema, "id")
 	setValidateDiagFuncToSchema(dsSchema, "id", stringIsInt)
-	deleteFieldsFromSchema(dsSchema, "password", "url")
+	deleteFieldsFromSchema(dsSchema, "application_credential_id", "application_credential_secret", "password", "rotation_trigger", "url", "validate")
 	return dsSchema
 }
 
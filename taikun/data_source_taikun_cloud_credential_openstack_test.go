@@ -37,6 +37,7 @@ func TestAccDataSourceTaikunCloudCredentialOpenStack(t *testing.T) {
 					map[string]struct{}{
 						"password": {},
 						"url":      {},
+						"validate": {},
 					},
 				),
 			},
@@ -0,0 +1,52 @@
+package taikun
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDataSourceTaikunCloudCredentialConfig = `
+resource "taikun_cloud_credential_openstack" "foo" {
+  name = "%s"
+}
+
+data "taikun_cloud_credential" "by_id" {
+  id = resource.taikun_cloud_credential_openstack.foo.id
+}
+
+data "taikun_cloud_credential" "by_name" {
+  name            = resource.taikun_cloud_credential_openstack.foo.name
+  organization_id = resource.taikun_cloud_credential_openstack.foo.organization_id
+}
+`
+
+func TestAccDataSourceTaikunCloudCredential(t *testing.T) {
+	cloudCredentialName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunCloudCredentialConfig,
+					cloudCredentialName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.taikun_cloud_credential.by_id", "id", "taikun_cloud_credential_openstack.foo", "id"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credential.by_id", "cloud_type", "OpenStack"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credential.by_id", "name", cloudCredentialName),
+					resource.TestCheckResourceAttrPair("data.taikun_cloud_credential.by_id", "organization_id", "taikun_cloud_credential_openstack.foo", "organization_id"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credential.by_id", "aws.#", "0"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credential.by_id", "openstack.#", "1"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credential.by_id", "openstack.0.region", os.Getenv("OS_REGION_NAME")),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credential.by_id", "openstack.0.project_name", os.Getenv("OS_PROJECT_NAME")),
+					resource.TestCheckResourceAttrPair("data.taikun_cloud_credential.by_name", "id", "taikun_cloud_credential_openstack.foo", "id"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credential.by_name", "cloud_type", "OpenStack"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package taikun
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/cloud_credentials"
+)
+
+func dataSourceTaikunCloudCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieve all cloud credentials, regardless of their cloud type.",
+		ReadContext: dataSourceTaikunCloudCredentialsRead,
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
+			"cloud_credentials": {
+				Description: "List of retrieved cloud credentials.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: dataSourceSchemaFromResourceSchema(dataSourceTaikunCloudCredentialSchema()),
+				},
+			},
+			"cloud_type": {
+				Description: "Cloud type filter: `AWS`, `Azure`, `GCP` or `OpenStack`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					cloudTypeAWS,
+					cloudTypeAzure,
+					cloudTypeGCP,
+					cloudTypeOpenStack,
+				}, false),
+			},
+			"organization_id": {
+				Description:      "Organization ID filter.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsInt,
+			},
+		}, "cloud_credentials", "name"),
+	}
+}
+
+func dataSourceTaikunCloudCredentialsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+	dataSourceID := "all"
+
+	params := cloud_credentials.NewCloudCredentialsDashboardListParams().WithV(ApiVersion)
+
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
+	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
+	if organizationIDProvided {
+		dataSourceID = organizationIDData.(string)
+		organizationID, err := atoi32(dataSourceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		params = params.WithOrganizationID(&organizationID)
+	}
+
+	cloudCredentials, err := dataSourceTaikunCloudCredentialsList(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if cloudType, cloudTypeProvided := d.GetOk("cloud_type"); cloudTypeProvided {
+		dataSourceID += "-" + cloudType.(string)
+		filtered := make([]map[string]interface{}, 0)
+		for _, cloudCredential := range cloudCredentials {
+			if cloudCredential["cloud_type"] == cloudType {
+				filtered = append(filtered, cloudCredential)
+			}
+		}
+		cloudCredentials = filtered
+	}
+
+	cloudCredentials = filter.apply(cloudCredentials)
+
+	if err := d.Set("cloud_credentials", cloudCredentials); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dataSourceID)
+
+	return nil
+}
+
+// dataSourceTaikunCloudCredentialsList returns the cloud credentials of all
+// cloud types matching the params, flattened for dataSourceTaikunCloudCredentialSchema
+func dataSourceTaikunCloudCredentialsList(params *cloud_credentials.CloudCredentialsDashboardListParams, apiClient *taikungoclient.Client) ([]map[string]interface{}, error) {
+	cloudCredentials := map[string][]map[string]interface{}{}
+	for {
+		response, err := apiClient.Client.CloudCredentials.CloudCredentialsDashboardList(params, apiClient)
+		if err != nil {
+			return nil, err
+		}
+		payload := response.GetPayload()
+
+		for _, rawCloudCredential := range payload.Amazon {
+			cloudCredentials[cloudTypeAWS] = append(cloudCredentials[cloudTypeAWS], flattenTaikunCloudCredentialAWS(rawCloudCredential))
+		}
+		for _, rawCloudCredential := range payload.Azure {
+			cloudCredentials[cloudTypeAzure] = append(cloudCredentials[cloudTypeAzure], flattenTaikunCloudCredentialAzure(rawCloudCredential))
+		}
+		for _, rawCloudCredential := range payload.Google {
+			cloudCredentials[cloudTypeGCP] = append(cloudCredentials[cloudTypeGCP], flattenTaikunCloudCredentialGCP(rawCloudCredential))
+		}
+		for _, rawCloudCredential := range payload.Openstack {
+			cloudCredentials[cloudTypeOpenStack] = append(cloudCredentials[cloudTypeOpenStack], flattenTaikunCloudCredentialOpenStack(rawCloudCredential))
+		}
+
+		// The offset applies to the list of each cloud type
+		offset := 0
+		complete := true
+		for cloudType, totalCount := range map[string]int32{
+			cloudTypeAWS:       payload.TotalCountAws,
+			cloudTypeAzure:     payload.TotalCountAzure,
+			cloudTypeGCP:       payload.TotalCountGoogle,
+			cloudTypeOpenStack: payload.TotalCountOpenstack,
+		} {
+			count := len(cloudCredentials[cloudType])
+			if count < int(totalCount) {
+				complete = false
+			}
+			if count > offset {
+				offset = count
+			}
+		}
+		pageIsEmpty := len(payload.Amazon)+len(payload.Azure)+len(payload.Google)+len(payload.Openstack) == 0
+		if complete || pageIsEmpty {
+			break
+		}
+		offset32 := int32(offset)
+		params = params.WithOffset(&offset32)
+	}
+
+	result := make([]map[string]interface{}, 0)
+	for _, cloudType := range []string{cloudTypeAWS, cloudTypeAzure, cloudTypeGCP, cloudTypeOpenStack} {
+		for _, cloudCredential := range cloudCredentials[cloudType] {
+			result = append(result, dataSourceTaikunCloudCredentialFromCloudMap(cloudType, cloudCredential))
+		}
+	}
+	return result, nil
+}
+
+// dataSourceTaikunCloudCredentialFromCloudMap moves the cloud specific fields
+// of a flattened cloud credential to the block of its cloud type
+func dataSourceTaikunCloudCredentialFromCloudMap(cloudType string, cloudMap map[string]interface{}) map[string]interface{} {
+	cloudCredential := map[string]interface{}{
+		"cloud_type": cloudType,
+	}
+	for _, field := range cloudCredentialCommonFields {
+		if value, ok := cloudMap[field]; ok {
+			cloudCredential[field] = value
+			delete(cloudMap, field)
+		}
+	}
+	for blockCloudType, block := range cloudCredentialBlocks {
+		if blockCloudType == cloudType {
+			cloudCredential[block] = []map[string]interface{}{cloudMap}
+		} else {
+			cloudCredential[block] = []map[string]interface{}{}
+		}
+	}
+	return cloudCredential
+}
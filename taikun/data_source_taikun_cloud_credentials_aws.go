@@ -15,7 +15,7 @@ func dataSourceTaikunCloudCredentialsAWS() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all AWS cloud credentials.",
 		ReadContext: dataSourceTaikunCloudCredentialsAWSRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credentials": {
 				Description: "List of retrieved AWS cloud credentials.",
 				Type:        schema.TypeList,
@@ -30,7 +30,7 @@ func dataSourceTaikunCloudCredentialsAWS() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "cloud_credentials", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunCloudCredentialsAWSRead(_ context.Context, d *schema.Resour
 
 	params := cloud_credentials.NewCloudCredentialsDashboardListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -68,6 +74,8 @@ func dataSourceTaikunCloudCredentialsAWSRead(_ context.Context, d *schema.Resour
 	for i, rawCloudCredential := range cloudCredentialsList {
 		cloudCredentials[i] = flattenTaikunCloudCredentialAWS(rawCloudCredential)
 	}
+	cloudCredentials = filter.apply(cloudCredentials)
+
 	if err := d.Set("cloud_credentials", cloudCredentials); err != nil {
 		return diag.FromErr(err)
 	}
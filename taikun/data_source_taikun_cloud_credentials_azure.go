@@ -15,7 +15,7 @@ func dataSourceTaikunCloudCredentialsAzure() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all Azure cloud credentials.",
 		ReadContext: dataSourceTaikunCloudCredentialsAzureRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credentials": {
 				Description: "List of retrieved Azure cloud credentials.",
 				Type:        schema.TypeList,
@@ -30,7 +30,7 @@ func dataSourceTaikunCloudCredentialsAzure() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "cloud_credentials", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunCloudCredentialsAzureRead(_ context.Context, d *schema.Reso
 
 	params := cloud_credentials.NewCloudCredentialsDashboardListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -68,6 +74,8 @@ func dataSourceTaikunCloudCredentialsAzureRead(_ context.Context, d *schema.Reso
 	for i, rawCloudCredential := range cloudCredentialsList {
 		cloudCredentials[i] = flattenTaikunCloudCredentialAzure(rawCloudCredential)
 	}
+	cloudCredentials = filter.apply(cloudCredentials)
+
 	if err := d.Set("cloud_credentials", cloudCredentials); err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,7 @@ func dataSourceTaikunCloudCredentialsGCP() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all Google Cloud Platform credentials.",
 		ReadContext: dataSourceTaikunCloudCredentialsGCPRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credentials": {
 				Description: "List of retrieved Google Cloud Platform credentials.",
 				Type:        schema.TypeList,
@@ -29,7 +29,7 @@ func dataSourceTaikunCloudCredentialsGCP() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "cloud_credentials", "name"),
 	}
 }
 
@@ -39,6 +39,12 @@ func dataSourceTaikunCloudCredentialsGCPRead(_ context.Context, d *schema.Resour
 
 	params := cloud_credentials.NewCloudCredentialsDashboardListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -67,6 +73,8 @@ func dataSourceTaikunCloudCredentialsGCPRead(_ context.Context, d *schema.Resour
 	for i, rawCloudCredential := range cloudCredentialsList {
 		cloudCredentials[i] = flattenTaikunCloudCredentialGCP(rawCloudCredential)
 	}
+	cloudCredentials = filter.apply(cloudCredentials)
+
 	if err := d.Set("cloud_credentials", cloudCredentials); err != nil {
 		return diag.FromErr(err)
 	}
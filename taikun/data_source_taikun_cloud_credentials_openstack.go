@@ -15,7 +15,7 @@ func dataSourceTaikunCloudCredentialsOpenStack() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all OpenStack cloud credentials.",
 		ReadContext: dataSourceTaikunCloudCredentialsOpenStackRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credentials": {
 				Description: "List of retrieved OpenStack cloud credentials.",
 				Type:        schema.TypeList,
@@ -30,7 +30,7 @@ func dataSourceTaikunCloudCredentialsOpenStack() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "cloud_credentials", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunCloudCredentialsOpenStackRead(_ context.Context, d *schema.
 
 	params := cloud_credentials.NewCloudCredentialsDashboardListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -68,6 +74,8 @@ func dataSourceTaikunCloudCredentialsOpenStackRead(_ context.Context, d *schema.
 	for i, rawCloudCredential := range cloudCredentialsList {
 		cloudCredentials[i] = flattenTaikunCloudCredentialOpenStack(rawCloudCredential)
 	}
+	cloudCredentials = filter.apply(cloudCredentials)
+
 	if err := d.Set("cloud_credentials", cloudCredentials); err != nil {
 		return diag.FromErr(err)
 	}
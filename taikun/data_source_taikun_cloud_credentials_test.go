@@ -0,0 +1,66 @@
+package taikun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDataSourceTaikunCloudCredentialsConfig = `
+resource "taikun_organization" "foo" {
+  name = "%s"
+  full_name = "%s"
+  discount_rate = 42
+}
+
+resource "taikun_cloud_credential_openstack" "foo" {
+  name = "%s"
+  organization_id = resource.taikun_organization.foo.id
+}
+
+data "taikun_cloud_credentials" "all" {
+  organization_id = resource.taikun_organization.foo.id
+
+  depends_on = [
+    taikun_cloud_credential_openstack.foo
+  ]
+}
+
+data "taikun_cloud_credentials" "aws" {
+  organization_id = resource.taikun_organization.foo.id
+  cloud_type      = "AWS"
+
+  depends_on = [
+    taikun_cloud_credential_openstack.foo
+  ]
+}`
+
+func TestAccDataSourceTaikunCloudCredentials(t *testing.T) {
+	organizationName := randomTestName()
+	organizationFullName := randomTestName()
+	cloudCredentialName := randomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunCloudCredentialsConfig,
+					organizationName,
+					organizationFullName,
+					cloudCredentialName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_cloud_credentials.all", "cloud_credentials.#", "1"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credentials.all", "cloud_credentials.0.cloud_type", "OpenStack"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credentials.all", "cloud_credentials.0.name", cloudCredentialName),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credentials.all", "cloud_credentials.0.organization_name", organizationName),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credentials.all", "cloud_credentials.0.openstack.#", "1"),
+					resource.TestCheckResourceAttrSet("data.taikun_cloud_credentials.all", "cloud_credentials.0.openstack.0.project_id"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_credentials.aws", "cloud_credentials.#", "0"),
+				),
+			},
+		},
+	})
+}
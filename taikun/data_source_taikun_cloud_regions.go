@@ -0,0 +1,160 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/cloud_credentials"
+)
+
+var cloudLocationCredentialsArguments = []string{"aws", "azure", "cloud_credential_id", "gcp", "openstack"}
+
+// dataSourceTaikunCloudLocationSchema returns the arguments shared by the
+// taikun_cloud_regions and taikun_cloud_availability_zones data sources: one
+// block of credentials per cloud type, built from the cloud credential
+// resources, or the ID of an existing cloud credential
+func dataSourceTaikunCloudLocationSchema() map[string]*schema.Schema {
+	resourceSchemas := map[string]map[string]*schema.Schema{
+		cloudTypeAWS:       resourceTaikunCloudCredentialAWSSchema(),
+		cloudTypeAzure:     resourceTaikunCloudCredentialAzureSchema(),
+		cloudTypeGCP:       resourceTaikunCloudCredentialGCPSchema(),
+		cloudTypeOpenStack: resourceTaikunCloudCredentialOpenStackSchema(),
+	}
+
+	locationSchema := map[string]*schema.Schema{
+		"cloud_credential_id": {
+			Description:      "ID of an existing cloud credential. The other regions and availability zones are not listed in that case, only the cloud credential's own region and availability zone are returned, since the API does not return its secrets.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: stringIsInt,
+			ExactlyOneOf:     cloudLocationCredentialsArguments,
+		},
+		"cloud_type": {
+			Description: "The cloud type: `AWS`, `Azure`, `GCP` or `OpenStack`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	for cloudType, resourceSchema := range resourceSchemas {
+		blockSchema := map[string]*schema.Schema{}
+		for _, key := range cloudCredentialLocationKeys[cloudType] {
+			// The cross attribute constraints refer to the resource's top level attributes
+			attributeSchema := *resourceSchema[key]
+			attributeSchema.ForceNew = false
+			attributeSchema.ConflictsWith = nil
+			attributeSchema.ExactlyOneOf = nil
+			attributeSchema.RequiredWith = nil
+			blockSchema[key] = &attributeSchema
+		}
+		block := cloudCredentialBlocks[cloudType]
+		locationSchema[block] = &schema.Schema{
+			Description:  fmt.Sprintf("The %s credentials, the attributes default to the same environment variables as in `taikun_cloud_credential_%s`.", cloudType, block),
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: cloudLocationCredentialsArguments,
+			Elem: &schema.Resource{
+				Schema: blockSchema,
+			},
+		}
+	}
+
+	return locationSchema
+}
+
+func dataSourceTaikunCloudRegions() *schema.Resource {
+	regionsSchema := dataSourceTaikunCloudLocationSchema()
+	regionsSchema["regions"] = &schema.Schema{
+		Description: "List of available regions, or locations for Azure. Only holds the cloud credential's region if `cloud_credential_id` is set.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Retrieve the regions available with the given cloud credentials.",
+		ReadContext: dataSourceTaikunCloudRegionsRead,
+		Schema:      regionsSchema,
+	}
+}
+
+func dataSourceTaikunCloudRegionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	var regions []string
+	cloudType, cloudCredential, err := dataSourceTaikunCloudLocationGetCloudCredential(d, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cloudCredential != nil {
+		regions = []string{cloudCredential[cloudCredentialLocations[cloudType].region].(string)}
+		d.SetId(d.Get("cloud_credential_id").(string))
+	} else {
+		var get cloudCredentialGetter
+		cloudType, get = dataSourceTaikunCloudLocationGetCredentials(d)
+		regions, err = cloudCredentialListRegions(cloudType, get, apiClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(cloudType)
+	}
+
+	if err := d.Set("cloud_type", cloudType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("regions", regions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// dataSourceTaikunCloudLocationGetCredentials returns the cloud type of the
+// credentials block which is set and a getter of its attributes
+func dataSourceTaikunCloudLocationGetCredentials(d *schema.ResourceData) (string, cloudCredentialGetter) {
+	for cloudType, block := range cloudCredentialBlocks {
+		if blocks := d.Get(block).([]interface{}); len(blocks) == 1 && blocks[0] != nil {
+			credentials := blocks[0].(map[string]interface{})
+			return cloudType, func(key string) interface{} {
+				return credentials[key]
+			}
+		}
+	}
+	// Unreachable, one of the blocks or cloud_credential_id is required
+	return "", nil
+}
+
+// dataSourceTaikunCloudLocationGetCloudCredential returns the cloud type and
+// the cloud specific attributes of the cloud credential if cloud_credential_id is set.
+// Taikun only lists regions and zones for the secrets given in the request and
+// does not return the secrets of a cloud credential, so the data sources can
+// only echo the region and zone stored in the cloud credential.
+func dataSourceTaikunCloudLocationGetCloudCredential(d *schema.ResourceData, apiClient *taikungoclient.Client) (string, map[string]interface{}, error) {
+	cloudCredentialIDData, cloudCredentialIDIsSet := d.GetOk("cloud_credential_id")
+	if !cloudCredentialIDIsSet {
+		return "", nil, nil
+	}
+	cloudCredentialID, err := atoi32(cloudCredentialIDData.(string))
+	if err != nil {
+		return "", nil, err
+	}
+
+	params := cloud_credentials.NewCloudCredentialsDashboardListParams().WithV(ApiVersion).WithID(&cloudCredentialID)
+	cloudCredentials, err := dataSourceTaikunCloudCredentialsList(params, apiClient)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(cloudCredentials) != 1 {
+		return "", nil, fmt.Errorf("cloud credential with ID %d not found", cloudCredentialID)
+	}
+
+	cloudType := cloudCredentials[0]["cloud_type"].(string)
+	block := cloudCredentials[0][cloudCredentialBlocks[cloudType]].([]map[string]interface{})
+	return cloudType, block[0], nil
+}
@@ -0,0 +1,63 @@
+package taikun
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDataSourceTaikunCloudRegionsAWSConfig = `
+data "taikun_cloud_regions" "foo" {
+  aws {}
+}
+`
+
+func TestAccDataSourceTaikunCloudRegionsAWS(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWS(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTaikunCloudRegionsAWSConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_cloud_regions.foo", "cloud_type", "AWS"),
+					resource.TestCheckResourceAttrSet("data.taikun_cloud_regions.foo", "regions.#"),
+					resource.TestCheckTypeSetElemAttr("data.taikun_cloud_regions.foo", "regions.*", os.Getenv("AWS_DEFAULT_REGION")),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceTaikunCloudRegionsByCloudCredentialConfig = `
+resource "taikun_cloud_credential_openstack" "foo" {
+  name = "%s"
+}
+
+data "taikun_cloud_regions" "foo" {
+  cloud_credential_id = resource.taikun_cloud_credential_openstack.foo.id
+}
+`
+
+func TestAccDataSourceTaikunCloudRegionsByCloudCredential(t *testing.T) {
+	cloudCredentialName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunCloudRegionsByCloudCredentialConfig,
+					cloudCredentialName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_cloud_regions.foo", "cloud_type", "OpenStack"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_regions.foo", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.taikun_cloud_regions.foo", "regions.0", os.Getenv("OS_REGION_NAME")),
+				),
+			},
+		},
+	})
+}
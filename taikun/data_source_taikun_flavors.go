@@ -2,79 +2,157 @@ package taikun
 
 import (
 	"context"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/itera-io/taikungoclient"
 	"github.com/itera-io/taikungoclient/client/cloud_credentials"
+	"github.com/itera-io/taikungoclient/client/flavors"
 	"github.com/itera-io/taikungoclient/models"
 )
 
+const (
+	flavorArchitectureARM64 = "arm64"
+	flavorArchitectureX8664 = "x86_64"
+)
+
+// AWS instance families, e.g. m6gd: the generation is followed by the attributes
+var awsFlavorFamilyRegexp = regexp.MustCompile(`^[a-z]+[0-9]+([a-z-]*)$`)
+
+// Azure sizes, e.g. Standard_NC24ads_A100_v4: the family is followed by the
+// vCPU count and the additive features
+var azureFlavorNameRegexp = regexp.MustCompile(`^(?:Standard|Basic)_([A-Z]+)[0-9]+(?:-[0-9]+)?([a-z]*)`)
+
 func dataSourceTaikunFlavors() *schema.Resource {
-	return &schema.Resource{
-		Description: "Retrieve flavors for a given cloud credential.",
-		ReadContext: dataSourceTaikunFlavorsRead,
-		Schema: map[string]*schema.Schema{
-			"cloud_credential_id": {
-				Description:      "Cloud credential ID.",
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: stringIsInt,
-			},
-			"flavors": {
-				Description: "List of retrieved flavors.",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cpu": {
-							Description: "CPU count.",
-							Type:        schema.TypeInt,
-							Computed:    true,
-						},
-						"name": {
-							Description: "Flavor name.",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"ram": {
-							Description: "RAM size.",
-							Type:        schema.TypeInt,
-							Computed:    true,
-						},
+	flavorsSchema := addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
+		"architecture": {
+			Description:  "Only keep the flavors of the given CPU architecture: `arm64` or `x86_64`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{flavorArchitectureARM64, flavorArchitectureX8664}, false),
+		},
+		"cloud_credential_id": {
+			Description:      "Cloud credential ID.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: stringIsInt,
+		},
+		"flavors": {
+			Description: "List of retrieved flavors.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"architecture": {
+						Description: "CPU architecture, `arm64` or `x86_64`, derived from the flavor name (empty for OpenStack).",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"cpu": {
+						Description: "CPU count.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"family": {
+						Description: "Cloud specific family of the flavor, e.g. `m5` for AWS, `D` for Azure, `n2` for GCP or `m1` for OpenStack.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"gpu": {
+						Description: "Whether the flavor has GPUs, derived from its family.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"linux_price": {
+						Description: "Price of the flavor running Linux, as listed by Taikun (0 if Taikun has no price for the flavor, always 0 for OpenStack).",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"linux_spot_price": {
+						Description: "Spot price of the flavor running Linux, as listed by Taikun (0 if Taikun has no price for the flavor, always 0 for OpenStack).",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"max_data_disk_count": {
+						Description: "Maximal number of data disks which can be attached.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"name": {
+						Description: "Flavor name.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ram": {
+						Description: "RAM size.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"windows_price": {
+						Description: "Price of the flavor running Windows, as listed by Taikun (0 if Taikun has no price for the flavor, always 0 for OpenStack).",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"windows_spot_price": {
+						Description: "Spot price of the flavor running Windows, as listed by Taikun (0 if Taikun has no price for the flavor, always 0 for OpenStack).",
+						Type:        schema.TypeFloat,
+						Computed:    true,
 					},
 				},
 			},
-			"max_cpu": {
-				Description:  "Maximal CPU count.",
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      36,
-				ValidateFunc: validation.IntBetween(2, 36),
-			},
-			"max_ram": {
-				Description:  "Maximal RAM size in GB.",
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      500,
-				ValidateFunc: validation.IntBetween(2, 500),
-			},
-			"min_cpu": {
-				Description:  "Minimal CPU count.",
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      2,
-				ValidateFunc: validation.IntBetween(2, 36),
-			},
-			"min_ram": {
-				Description:  "Minimal RAM size in GB.",
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      2,
-				ValidateFunc: validation.IntBetween(2, 500),
-			},
 		},
+		"gpu": {
+			Description: "If set, only keep the flavors with GPUs (`true`) or without GPUs (`false`).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"max_cpu": {
+			Description:  "Maximal CPU count.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      36,
+			ValidateFunc: validation.IntBetween(2, 36),
+		},
+		"max_ram": {
+			Description:  "Maximal RAM size in GB.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      500,
+			ValidateFunc: validation.IntBetween(2, 500),
+		},
+		"min_cpu": {
+			Description:  "Minimal CPU count.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validation.IntBetween(2, 36),
+		},
+		"min_ram": {
+			Description:  "Minimal RAM size in GB.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validation.IntBetween(2, 500),
+		},
+		"most_suitable": {
+			Description: "Only keep the smallest flavor, by CPU count then RAM size, matching the other arguments. The data source fails if no flavor matches.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}, "flavors", "name")
+
+	// Flavors have no ID, most_suitable is used to select a single flavor instead
+	deleteFieldsFromSchema(flavorsSchema, "most_recent")
+
+	return &schema.Resource{
+		Description: "Retrieve flavors for a given cloud credential.",
+		ReadContext: dataSourceTaikunFlavorsRead,
+		Schema:      flavorsSchema,
 	}
 }
 
@@ -105,12 +183,17 @@ func dataSourceTaikunFlavorsRead(_ context.Context, d *schema.ResourceData, meta
 		endRAM = float64(gibiByteToByte(d.Get("max_ram").(int)))
 	}
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	sortBy := "name"
 	sortDir := "asc"
 
 	params := cloud_credentials.NewCloudCredentialsAllFlavorsParams().WithV(ApiVersion).WithCloudID(cloudCredentialID)
 	params = params.WithStartCPU(&startCPU).WithEndCPU(&endCPU).WithStartRAM(&startRAM).WithEndRAM(&endRAM)
-	params = params.WithSortBy(&sortBy).WithSortDirection(&sortDir)
+	params = params.WithSortBy(&sortBy).WithSortDirection(&sortDir).WithSearch(filter.search())
 
 	var flavorDTOs []*models.FlavorsListDto
 	for {
@@ -126,7 +209,35 @@ func dataSourceTaikunFlavorsRead(_ context.Context, d *schema.ResourceData, meta
 		params = params.WithOffset(&offset)
 	}
 
+	prices, err := dataSourceTaikunFlavorsGetPrices(cloudType, cloudCredentialID, startCPU, endCPU, startRAM, endRAM, filter.search(), apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flavors := flattenDataSourceTaikunFlavors(cloudType, flavorDTOs)
+	for _, flavor := range flavors {
+		flavorPrices := prices[flavor["name"].(string)]
+		flavor["linux_price"] = flavorPrices.linux
+		flavor["linux_spot_price"] = flavorPrices.linuxSpot
+		flavor["windows_price"] = flavorPrices.windows
+		flavor["windows_spot_price"] = flavorPrices.windowsSpot
+	}
+	flavors = filter.apply(flavors)
+
+	if architecture, architectureIsSet := d.GetOk("architecture"); architectureIsSet {
+		flavors = dataSourceTaikunFlavorsWithAttribute(flavors, "architecture", architecture)
+	}
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("gpu").IsNull() {
+		flavors = dataSourceTaikunFlavorsWithAttribute(flavors, "gpu", d.Get("gpu"))
+	}
+
+	if d.Get("most_suitable").(bool) {
+		if len(flavors) == 0 {
+			return diag.Errorf("no flavor of cloud credential %d matches the given constraints", cloudCredentialID)
+		}
+		flavors = []map[string]interface{}{dataSourceTaikunFlavorsMostSuitable(flavors)}
+	}
+
 	if err := d.Set("flavors", flavors); err != nil {
 		return diag.FromErr(err)
 	}
@@ -160,33 +271,185 @@ func getFlattenDataSourceTaikunFlavorsItemFunc(cloudType string) flattenDataSour
 }
 
 func flattenDataSourceTaikunFlavorsAWSItem(flavorDTO *models.FlavorsListDto) map[string]interface{} {
+	family := strings.SplitN(flavorDTO.Name, ".", 2)[0]
+	architecture := flavorArchitectureX8664
+	// Graviton families have a g among their attributes, e.g. m6g or c7gn
+	if match := awsFlavorFamilyRegexp.FindStringSubmatch(family); family == "a1" || (match != nil && strings.Contains(match[1], "g")) {
+		architecture = flavorArchitectureARM64
+	}
 	return map[string]interface{}{
-		"cpu":  flavorDTO.CPU,
-		"name": flavorDTO.Name,
-		"ram":  mebiByteToGibiByte(flavorDTO.RAM),
+		"architecture":        architecture,
+		"cpu":                 flavorDTO.CPU,
+		"family":              family,
+		"gpu":                 strings.HasPrefix(family, "g") || strings.HasPrefix(family, "p"),
+		"max_data_disk_count": int(flavorDTO.MaxDataDiskCount),
+		"name":                flavorDTO.Name,
+		"ram":                 mebiByteToGibiByte(flavorDTO.RAM),
 	}
 }
 
 func flattenDataSourceTaikunFlavorsAzureItem(flavorDTO *models.FlavorsListDto) map[string]interface{} {
+	var family string
+	architecture := flavorArchitectureX8664
+	// e.g. Standard_D2ps_v5, the p among the additive features marks Arm based sizes
+	if match := azureFlavorNameRegexp.FindStringSubmatch(flavorDTO.Name); match != nil {
+		family = match[1]
+		if strings.Contains(match[2], "p") {
+			architecture = flavorArchitectureARM64
+		}
+	}
 	return map[string]interface{}{
-		"cpu":  flavorDTO.CPU,
-		"name": flavorDTO.Name,
-		"ram":  flavorDTO.RAM,
+		"architecture":        architecture,
+		"cpu":                 flavorDTO.CPU,
+		"family":              family,
+		"gpu":                 strings.HasPrefix(family, "N"),
+		"max_data_disk_count": int(flavorDTO.MaxDataDiskCount),
+		"name":                flavorDTO.Name,
+		"ram":                 flavorDTO.RAM,
 	}
 }
 
 func flattenDataSourceTaikunFlavorsOpenStackItem(flavorDTO *models.FlavorsListDto) map[string]interface{} {
+	var family string
+	if nameParts := strings.SplitN(flavorDTO.Name, ".", 2); len(nameParts) == 2 {
+		family = nameParts[0]
+	}
 	return map[string]interface{}{
-		"cpu":  flavorDTO.CPU,
-		"name": flavorDTO.Name,
-		"ram":  mebiByteToGibiByte(flavorDTO.RAM),
+		"architecture":        "",
+		"cpu":                 flavorDTO.CPU,
+		"family":              family,
+		"gpu":                 strings.Contains(strings.ToLower(flavorDTO.Name), "gpu"),
+		"max_data_disk_count": int(flavorDTO.MaxDataDiskCount),
+		"name":                flavorDTO.Name,
+		"ram":                 mebiByteToGibiByte(flavorDTO.RAM),
 	}
 }
 
 func flattenDataSourceTaikunFlavorsGCPItem(flavorDTO *models.FlavorsListDto) map[string]interface{} {
+	family := strings.SplitN(flavorDTO.Name, "-", 2)[0]
+	architecture := flavorArchitectureX8664
+	if family == "t2a" || family == "c4a" {
+		architecture = flavorArchitectureARM64
+	}
 	return map[string]interface{}{
-		"cpu":  flavorDTO.CPU,
-		"name": flavorDTO.Name,
-		"ram":  byteToGibiByte(flavorDTO.RAM),
+		"architecture":        architecture,
+		"cpu":                 flavorDTO.CPU,
+		"family":              family,
+		"gpu":                 family == "a2" || family == "a3" || family == "g2",
+		"max_data_disk_count": int(flavorDTO.MaxDataDiskCount),
+		"name":                flavorDTO.Name,
+		"ram":                 byteToGibiByte(flavorDTO.RAM),
+	}
+}
+
+type flavorPrices struct {
+	linux       float64
+	linuxSpot   float64
+	windows     float64
+	windowsSpot float64
+}
+
+func newFlavorPrices(linux string, linuxSpot string, windows string, windowsSpot string) flavorPrices {
+	parsePrice := func(price string) float64 {
+		value, _ := strconv.ParseFloat(price, 64)
+		return value
+	}
+	return flavorPrices{
+		linux:       parsePrice(linux),
+		linuxSpot:   parsePrice(linuxSpot),
+		windows:     parsePrice(windows),
+		windowsSpot: parsePrice(windowsSpot),
+	}
+}
+
+// dataSourceTaikunFlavorsGetPrices returns the prices of the flavors by name,
+// Taikun only lists them for AWS, Azure and GCP
+func dataSourceTaikunFlavorsGetPrices(cloudType string, cloudCredentialID int32, startCPU int32, endCPU int32, startRAM float64, endRAM float64, search *string, apiClient *taikungoclient.Client) (map[string]flavorPrices, error) {
+	prices := make(map[string]flavorPrices)
+	var offset int32
+
+	switch cloudType {
+	case cloudTypeAWS:
+		params := flavors.NewFlavorsAwsFlavorsParams().WithV(ApiVersion).WithCloudID(cloudCredentialID)
+		params = params.WithStartCPU(&startCPU).WithEndCPU(&endCPU).WithStartRAM(&startRAM).WithEndRAM(&endRAM).WithSearch(search)
+		for {
+			response, err := apiClient.Client.Flavors.FlavorsAwsFlavors(params, apiClient)
+			if err != nil {
+				return nil, err
+			}
+			for _, flavorDTO := range response.Payload.Data {
+				prices[flavorDTO.Name] = newFlavorPrices(flavorDTO.LinuxPrice, flavorDTO.LinuxSpotPrice, flavorDTO.WindowsPrice, flavorDTO.WindowsSpotPrice)
+			}
+			offset += int32(len(response.Payload.Data))
+			if len(response.Payload.Data) == 0 || offset >= response.Payload.TotalCount {
+				break
+			}
+			params = params.WithOffset(&offset)
+		}
+	case cloudTypeAzure:
+		startRAM32, endRAM32 := int32(startRAM), int32(endRAM)
+		params := flavors.NewFlavorsAzureFlavorsParams().WithV(ApiVersion).WithCloudID(cloudCredentialID)
+		params = params.WithStartCPU(&startCPU).WithEndCPU(&endCPU).WithStartRAM(&startRAM32).WithEndRAM(&endRAM32).WithSearch(search)
+		for {
+			response, err := apiClient.Client.Flavors.FlavorsAzureFlavors(params, apiClient)
+			if err != nil {
+				return nil, err
+			}
+			for _, flavorDTO := range response.Payload.Data {
+				prices[flavorDTO.Name] = newFlavorPrices(flavorDTO.LinuxPrice, flavorDTO.LinuxSpotPrice, flavorDTO.WindowsPrice, flavorDTO.WindowsSpotPrice)
+			}
+			offset += int32(len(response.Payload.Data))
+			if len(response.Payload.Data) == 0 || offset >= response.Payload.TotalCount {
+				break
+			}
+			params = params.WithOffset(&offset)
+		}
+	case cloudTypeGCP:
+		params := flavors.NewFlavorsGoogleFlavorsParams().WithV(ApiVersion).WithCloudID(cloudCredentialID)
+		params = params.WithStartCPU(&startCPU).WithEndCPU(&endCPU).WithStartRAM(&startRAM).WithEndRAM(&endRAM).WithSearch(search)
+		for {
+			response, err := apiClient.Client.Flavors.FlavorsGoogleFlavors(params, apiClient)
+			if err != nil {
+				return nil, err
+			}
+			for _, flavorDTO := range response.Payload.Data {
+				prices[flavorDTO.Name] = newFlavorPrices(flavorDTO.LinuxPrice, flavorDTO.LinuxSpotPrice, flavorDTO.WindowsPrice, flavorDTO.WindowsSpotPrice)
+			}
+			offset += int32(len(response.Payload.Data))
+			if len(response.Payload.Data) == 0 || offset >= response.Payload.TotalCount {
+				break
+			}
+			params = params.WithOffset(&offset)
+		}
+	}
+
+	return prices, nil
+}
+
+func dataSourceTaikunFlavorsWithAttribute(flavors []map[string]interface{}, attribute string, value interface{}) []map[string]interface{} {
+	filtered := make([]map[string]interface{}, 0, len(flavors))
+	for _, flavor := range flavors {
+		if flavor[attribute] == value {
+			filtered = append(filtered, flavor)
+		}
+	}
+	return filtered
+}
+
+// dataSourceTaikunFlavorsMostSuitable returns the flavor with the fewest CPUs,
+// then the least RAM, the name breaks ties
+func dataSourceTaikunFlavorsMostSuitable(flavors []map[string]interface{}) map[string]interface{} {
+	mostSuitable := flavors[0]
+	for _, flavor := range flavors[1:] {
+		for _, attribute := range []string{"cpu", "ram", "name"} {
+			if dataSourceFilterValueIsLess(flavor[attribute], mostSuitable[attribute]) {
+				mostSuitable = flavor
+				break
+			}
+			if dataSourceFilterValueIsLess(mostSuitable[attribute], flavor[attribute]) {
+				break
+			}
+		}
 	}
+	return mostSuitable
 }
@@ -3,9 +3,12 @@ package taikun
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient/models"
 )
 
 const testAccDataSourceTaikunFlavorsAWSConfig = `
@@ -16,6 +19,7 @@ resource "taikun_cloud_credential_aws" "foo" {
 
 data "taikun_flavors" "foo" {
   cloud_credential_id = resource.taikun_cloud_credential_aws.foo.id
+  sort_by             = "linux_price"
 
   min_cpu = %d
   max_cpu = %d
@@ -45,6 +49,7 @@ func TestAccDataSourceTaikunFlavorsAWS(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.taikun_flavors.foo", "flavors.0.name"),
 					resource.TestCheckResourceAttr("data.taikun_flavors.foo", "flavors.0.cpu", fmt.Sprint(cpu)),
 					resource.TestCheckResourceAttr("data.taikun_flavors.foo", "flavors.0.ram", fmt.Sprint(ram)),
+					resource.TestCheckResourceAttrSet("data.taikun_flavors.foo", "flavors.0.linux_price"),
 				),
 			},
 		},
@@ -136,3 +141,111 @@ func TestAccDataSourceTaikunFlavorsOpenStack(t *testing.T) {
 		},
 	})
 }
+
+const testAccDataSourceTaikunFlavorsAWSMostSuitableConfig = `
+resource "taikun_cloud_credential_aws" "foo" {
+  name = "%s"
+  availability_zone = "%s"
+}
+
+data "taikun_flavors" "foo" {
+  cloud_credential_id = resource.taikun_cloud_credential_aws.foo.id
+
+  min_cpu       = 4
+  min_ram       = 16
+  name_regex    = "^m[0-9]+\\."
+  architecture  = "x86_64"
+  gpu           = false
+  most_suitable = true
+}
+`
+
+func TestAccDataSourceTaikunFlavorsAWSMostSuitable(t *testing.T) {
+	cloudCredentialName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWS(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunFlavorsAWSMostSuitableConfig,
+					cloudCredentialName,
+					os.Getenv("AWS_AVAILABILITY_ZONE"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_flavors.foo", "flavors.#", "1"),
+					resource.TestCheckResourceAttr("data.taikun_flavors.foo", "flavors.0.cpu", "4"),
+					resource.TestCheckResourceAttr("data.taikun_flavors.foo", "flavors.0.ram", "16"),
+					resource.TestCheckResourceAttr("data.taikun_flavors.foo", "flavors.0.architecture", "x86_64"),
+					resource.TestCheckResourceAttr("data.taikun_flavors.foo", "flavors.0.gpu", "false"),
+					resource.TestMatchResourceAttr("data.taikun_flavors.foo", "flavors.0.family", regexp.MustCompile(`^m[0-9]+`)),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenDataSourceTaikunFlavorsItem(t *testing.T) {
+	testCases := []struct {
+		cloudType    string
+		name         string
+		architecture string
+		family       string
+		gpu          bool
+	}{
+		{cloudTypeAWS, "m5.xlarge", "x86_64", "m5", false},
+		{cloudTypeAWS, "m6gd.large", "arm64", "m6gd", false},
+		{cloudTypeAWS, "a1.medium", "arm64", "a1", false},
+		{cloudTypeAWS, "g4dn.xlarge", "x86_64", "g4dn", true},
+		{cloudTypeAWS, "p4d.24xlarge", "x86_64", "p4d", true},
+		{cloudTypeAzure, "Standard_D2s_v3", "x86_64", "D", false},
+		{cloudTypeAzure, "Standard_D2ps_v5", "arm64", "D", false},
+		{cloudTypeAzure, "Standard_NC24ads_A100_v4", "x86_64", "NC", true},
+		{cloudTypeGCP, "n2-standard-4", "x86_64", "n2", false},
+		{cloudTypeGCP, "t2a-standard-4", "arm64", "t2a", false},
+		{cloudTypeGCP, "a2-highgpu-1g", "x86_64", "a2", true},
+		{cloudTypeOpenStack, "m1.large", "", "m1", false},
+		{cloudTypeOpenStack, "gpu-large", "", "", true},
+	}
+
+	for _, testCase := range testCases {
+		flavor := getFlattenDataSourceTaikunFlavorsItemFunc(testCase.cloudType)(&models.FlavorsListDto{Name: testCase.name, CPU: 2})
+		if flavor["architecture"] != testCase.architecture || flavor["family"] != testCase.family || flavor["gpu"] != testCase.gpu {
+			t.Errorf("%s flavor %s: expected architecture %q, family %q and gpu %t, got %v",
+				testCase.cloudType, testCase.name, testCase.architecture, testCase.family, testCase.gpu, flavor)
+		}
+	}
+}
+
+func TestDataSourceTaikunFlavorsMostSuitable(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceTaikunFlavors().Schema, map[string]interface{}{
+		"cloud_credential_id": "42",
+		"sort_by":             "cpu",
+	})
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flavors := filter.apply([]map[string]interface{}{
+		{"name": "c", "cpu": int32(8), "ram": int32(16)},
+		{"name": "b", "cpu": int32(4), "ram": int32(32)},
+		{"name": "a", "cpu": int32(4), "ram": int32(32)},
+		{"name": "d", "cpu": int32(4), "ram": int32(16)},
+	})
+	if flavors[0]["name"] != "b" || flavors[3]["name"] != "c" {
+		t.Fatalf("unexpected order %v", flavors)
+	}
+
+	if mostSuitable := dataSourceTaikunFlavorsMostSuitable(flavors); mostSuitable["name"] != "d" {
+		t.Fatalf("expected flavor d to be the most suitable, got %v", mostSuitable)
+	}
+}
+
+func TestNewFlavorPrices(t *testing.T) {
+	prices := newFlavorPrices("0.096", "0.0317", "", "not a price")
+	expected := flavorPrices{linux: 0.096, linuxSpot: 0.0317}
+	if prices != expected {
+		t.Errorf("expected prices %+v, got %+v", expected, prices)
+	}
+}
@@ -17,7 +17,7 @@ func dataSourceTaikunImagesAWS() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve images for a given AWS cloud credential.",
 		ReadContext: dataSourceTaikunImagesAWSRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addImageFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credential_id": {
 				Description:      "AWS cloud credential ID.",
 				Type:             schema.TypeString,
@@ -68,7 +68,7 @@ func dataSourceTaikunImagesAWS() *schema.Resource {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -84,11 +84,19 @@ func dataSourceTaikunImagesAWSRead(_ context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	filter, err := newImageFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	body := models.AwsImagesPostListCommand{
 		Latest:  d.Get("latest").(bool),
 		CloudID: cloudCredentialID,
 		Owners:  owners,
 	}
+	if search := filter.search(); search != nil {
+		body.Search = *search
+	}
 
 	params := images.NewImagesAwsImagesAsPostParams().WithV(ApiVersion).WithBody(&body)
 
@@ -107,6 +115,8 @@ func dataSourceTaikunImagesAWSRead(_ context.Context, d *schema.ResourceData, me
 		params = params.WithBody(&body)
 	}
 
+	imageList = filter.apply(imageList)
+
 	if err := d.Set("images", imageList); err != nil {
 		return diag.FromErr(err)
 	}
@@ -148,10 +158,10 @@ func flattenTaikunImagesAWS(rawImages ...*models.AwsExtendedImagesListDto) []map
 
 	images := make([]map[string]interface{}, len(rawImages))
 	for i, rawImage := range rawImages {
-		images[i] = map[string]interface{}{
+		images[i] = withImageOS(map[string]interface{}{
 			"id":   rawImage.ID,
 			"name": rawImage.Name,
-		}
+		}, rawImage.Name, rawImage.Description, rawImage.PlatformDetails)
 	}
 	return images
 }
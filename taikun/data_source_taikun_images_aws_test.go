@@ -41,3 +41,45 @@ func TestAccDataSourceTaikunImagesAWS(t *testing.T) {
 		},
 	})
 }
+
+const testAccDataSourceTaikunImagesAWSUbuntuConfig = `
+resource "taikun_cloud_credential_aws" "foo" {
+  name = "%s"
+  availability_zone = "%s"
+}
+
+data "taikun_images_aws" "foo" {
+  cloud_credential_id = resource.taikun_cloud_credential_aws.foo.id
+  owners = ["Canonical"]
+
+  os_family    = "ubuntu"
+  os_version   = "22.04"
+  architecture = "x86_64"
+  most_recent  = true
+}
+`
+
+func TestAccDataSourceTaikunImagesAWSUbuntu(t *testing.T) {
+	cloudCredentialName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWS(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunImagesAWSUbuntuConfig,
+					cloudCredentialName,
+					os.Getenv("AWS_AVAILABILITY_ZONE"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_images_aws.foo", "images.#", "1"),
+					resource.TestCheckResourceAttr("data.taikun_images_aws.foo", "images.0.os_family", "ubuntu"),
+					resource.TestCheckResourceAttr("data.taikun_images_aws.foo", "images.0.os_version", "22.04"),
+					resource.TestCheckResourceAttr("data.taikun_images_aws.foo", "images.0.architecture", "x86_64"),
+					resource.TestCheckResourceAttrSet("data.taikun_images_aws.foo", "images.0.build_date"),
+					resource.TestCheckResourceAttrSet("data.taikun_images_aws.foo", "images.0.id"),
+				),
+			},
+		},
+	})
+}
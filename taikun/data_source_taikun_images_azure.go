@@ -13,7 +13,7 @@ func dataSourceTaikunImagesAzure() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve images for a given Azure cloud credential.",
 		ReadContext: dataSourceTaikunImagesAzureRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addImageFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credential_id": {
 				Description:      "Azure cloud credential ID.",
 				Type:             schema.TypeString,
@@ -60,7 +60,7 @@ func dataSourceTaikunImagesAzure() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
-		},
+		}),
 	}
 }
 
@@ -73,11 +73,17 @@ func dataSourceTaikunImagesAzureRead(_ context.Context, d *schema.ResourceData,
 
 	latest := d.Get("latest").(bool)
 
+	filter, err := newImageFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	params := images.NewImagesAzureImagesParams().WithV(ApiVersion).WithCloudID(cloudCredentialID)
 	params = params.WithPublisherName(d.Get("publisher").(string))
 	params = params.WithOffer(d.Get("offer").(string))
 	params = params.WithSku(d.Get("sku").(string))
 	params = params.WithLatest(&latest)
+	params = params.WithSearch(filter.search())
 
 	var imageList []map[string]interface{}
 	for {
@@ -85,7 +91,7 @@ func dataSourceTaikunImagesAzureRead(_ context.Context, d *schema.ResourceData,
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		imageList = append(imageList, flattenTaikunImages(response.Payload.Data...)...)
+		imageList = append(imageList, flattenTaikunImagesWithOS(response.Payload.Data...)...)
 		if len(imageList) == int(response.Payload.TotalCount) {
 			break
 		}
@@ -93,6 +99,8 @@ func dataSourceTaikunImagesAzureRead(_ context.Context, d *schema.ResourceData,
 		params = params.WithOffset(&offset)
 	}
 
+	imageList = filter.apply(imageList)
+
 	if err := d.Set("images", imageList); err != nil {
 		return diag.FromErr(err)
 	}
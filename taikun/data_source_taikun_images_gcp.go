@@ -14,7 +14,7 @@ func dataSourceTaikunImagesGCP() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve images for a given GCP cloud credential.",
 		ReadContext: dataSourceTaikunImagesGCPRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addImageFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credential_id": {
 				Description:      "GCP cloud credential ID.",
 				Type:             schema.TypeString,
@@ -52,7 +52,7 @@ func dataSourceTaikunImagesGCP() *schema.Resource {
 					"windows",
 				}, false),
 			},
-		},
+		}),
 	}
 }
 
@@ -63,7 +63,13 @@ func dataSourceTaikunImagesGCPRead(_ context.Context, d *schema.ResourceData, me
 	}
 
 	apiClient := meta.(*taikungoclient.Client)
+	filter, err := newImageFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	params := images.NewImagesGoogleImagesParams().WithV(ApiVersion).WithCloudID(cloudCredentialID).WithType(d.Get("type").(string))
+	params = params.WithSearch(filter.search())
 
 	var imageList []map[string]interface{}
 	for {
@@ -71,7 +77,7 @@ func dataSourceTaikunImagesGCPRead(_ context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		imageList = append(imageList, flattenTaikunImages(response.Payload.Data...)...)
+		imageList = append(imageList, flattenTaikunImagesWithOS(response.Payload.Data...)...)
 		if len(imageList) == int(response.Payload.TotalCount) {
 			break
 		}
@@ -79,6 +85,8 @@ func dataSourceTaikunImagesGCPRead(_ context.Context, d *schema.ResourceData, me
 		params = params.WithOffset(&offset)
 	}
 
+	imageList = filter.apply(imageList)
+
 	if err := d.Set("images", imageList); err != nil {
 		return diag.FromErr(err)
 	}
@@ -13,7 +13,7 @@ func dataSourceTaikunImagesOpenStack() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve images for a given OpenStack cloud credential.",
 		ReadContext: dataSourceTaikunImagesOpenStackRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addImageFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"cloud_credential_id": {
 				Description:      "OpenStack cloud credential ID.",
 				Type:             schema.TypeString,
@@ -39,7 +39,7 @@ func dataSourceTaikunImagesOpenStack() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -50,7 +50,13 @@ func dataSourceTaikunImagesOpenStackRead(_ context.Context, d *schema.ResourceDa
 	}
 
 	apiClient := meta.(*taikungoclient.Client)
+	filter, err := newImageFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	params := images.NewImagesOpenstackImagesParams().WithV(ApiVersion).WithCloudID(cloudCredentialID)
+	params = params.WithSearch(filter.search())
 
 	var imageList []map[string]interface{}
 	for {
@@ -58,7 +64,7 @@ func dataSourceTaikunImagesOpenStackRead(_ context.Context, d *schema.ResourceDa
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		imageList = append(imageList, flattenTaikunImages(response.Payload.Data...)...)
+		imageList = append(imageList, flattenTaikunImagesWithOS(response.Payload.Data...)...)
 		if len(imageList) == int(response.Payload.TotalCount) {
 			break
 		}
@@ -66,6 +72,8 @@ func dataSourceTaikunImagesOpenStackRead(_ context.Context, d *schema.ResourceDa
 		params = params.WithOffset(&offset)
 	}
 
+	imageList = filter.apply(imageList)
+
 	if err := d.Set("images", imageList); err != nil {
 		return diag.FromErr(err)
 	}
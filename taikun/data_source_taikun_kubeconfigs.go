@@ -14,7 +14,7 @@ func dataSourceTaikunKubeconfigs() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve a project's kubeconfigs.",
 		ReadContext: dataSourceTaikunKubeconfigsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"kubeconfigs": {
 				Description: "List of retrieved kubeconfigs.",
 				Type:        schema.TypeList,
@@ -29,7 +29,7 @@ func dataSourceTaikunKubeconfigs() *schema.Resource {
 				Required:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "kubeconfigs", "name"),
 	}
 }
 
@@ -42,6 +42,12 @@ func dataSourceTaikunKubeconfigsRead(_ context.Context, d *schema.ResourceData,
 	}
 	params := kube_config.NewKubeConfigListParams().WithV(ApiVersion).WithProjectID(&projectID)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	var kubeconfigDTOs []*models.KubeConfigForUserDto
 	retrievedKubeconfigCount := 0
 	for {
@@ -72,6 +78,8 @@ func dataSourceTaikunKubeconfigsRead(_ context.Context, d *schema.ResourceData,
 		kubeconfigs[i] = flattenTaikunKubeconfig(kubeconfigDTO, kubeconfigContent)
 	}
 
+	kubeconfigs = filter.apply(kubeconfigs)
+
 	if err := d.Set("kubeconfigs", kubeconfigs); err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,7 @@ func dataSourceTaikunKubernetesProfiles() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all Kubernetes profiles.",
 		ReadContext: dataSourceTaikunKubernetesProfilesRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"kubernetes_profiles": {
 				Description: "List of retrieved Kubernetes profiles.",
 				Type:        schema.TypeList,
@@ -29,7 +29,7 @@ func dataSourceTaikunKubernetesProfiles() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: stringIsInt,
 			},
-		},
+		}, "kubernetes_profiles", "name"),
 	}
 }
 
@@ -39,6 +39,12 @@ func dataSourceTaikunKubernetesProfilesRead(_ context.Context, d *schema.Resourc
 
 	params := kubernetes_profiles.NewKubernetesProfilesListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -67,6 +73,8 @@ func dataSourceTaikunKubernetesProfilesRead(_ context.Context, d *schema.Resourc
 	for i, rawKubernetesProfile := range kubernetesProfilesListDtos {
 		kubernetesProfiles[i] = flattenTaikunKubernetesProfile(rawKubernetesProfile)
 	}
+	kubernetesProfiles = filter.apply(kubernetesProfiles)
+
 	if err := d.Set("kubernetes_profiles", kubernetesProfiles); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,121 @@
+package taikun
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/kubernetes"
+)
+
+func dataSourceTaikunKubernetesVersions() *schema.Resource {
+	kubernetesVersionsSchema := addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
+		"latest_version": {
+			Description: "The most recent Kubernetes version supported by Taikun.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"versions": {
+			Description: "List of Kubernetes versions supported by Taikun, from the oldest to the most recent.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"latest": {
+						Description: "Whether this is the most recent version supported by Taikun.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"version": {
+						Description: "The Kubernetes version, in the format vMAJOR.MINOR.PATCH.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}, "versions", "version")
+	// The versions are already sorted, from the oldest to the most recent
+	deleteFieldsFromSchema(kubernetesVersionsSchema, "most_recent", "sort_by")
+
+	return &schema.Resource{
+		Description: "Retrieve the Kubernetes versions supported by Taikun.",
+		ReadContext: dataSourceTaikunKubernetesVersionsRead,
+		Schema:      kubernetesVersionsSchema,
+	}
+}
+
+func dataSourceTaikunKubernetesVersionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+	filter, err := newDataSourceFilter(d, "version")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	kubernetesVersions, err := kubernetesVersionsList(apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions := make([]map[string]interface{}, len(kubernetesVersions))
+	var latestVersion string
+	for i, kubernetesVersion := range kubernetesVersions {
+		versions[i] = map[string]interface{}{
+			"latest":  false,
+			"version": kubernetesVersion,
+		}
+	}
+	if len(kubernetesVersions) != 0 {
+		sort.SliceStable(versions, func(i, j int) bool {
+			return kubernetesVersionIsLess(versions[i]["version"].(string), versions[j]["version"].(string))
+		})
+		versions[len(versions)-1]["latest"] = true
+		latestVersion = versions[len(versions)-1]["version"].(string)
+	}
+
+	versions = filter.apply(versions)
+
+	if err := d.Set("latest_version", latestVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("all")
+	return nil
+}
+
+// kubernetesVersionsList returns the Kubernetes versions supported by Taikun
+func kubernetesVersionsList(apiClient *taikungoclient.Client) ([]string, error) {
+	params := kubernetes.NewKubernetesGetSupportedListParams().WithV(ApiVersion)
+	response, err := apiClient.Client.Kubernetes.KubernetesGetSupportedList(params, apiClient)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// kubernetesVersionIsLess compares two vMAJOR.MINOR.PATCH versions
+func kubernetesVersionIsLess(a string, b string) bool {
+	aNumbers := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bNumbers := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(aNumbers) && i < len(bNumbers); i++ {
+		aNumber, aErr := strconv.Atoi(aNumbers[i])
+		bNumber, bErr := strconv.Atoi(bNumbers[i])
+		if aErr != nil || bErr != nil {
+			if aNumbers[i] != bNumbers[i] {
+				return aNumbers[i] < bNumbers[i]
+			}
+			continue
+		}
+		if aNumber != bNumber {
+			return aNumber < bNumber
+		}
+	}
+	return len(aNumbers) < len(bNumbers)
+}
@@ -0,0 +1,51 @@
+package taikun
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestKubernetesVersionIsLess(t *testing.T) {
+	versions := []string{"v1.22.10", "v1.9.11", "v1.22.2", "v1.21.6"}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return kubernetesVersionIsLess(versions[i], versions[j])
+	})
+
+	expected := []string{"v1.9.11", "v1.21.6", "v1.22.2", "v1.22.10"}
+	for i := range expected {
+		if versions[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, versions)
+		}
+	}
+}
+
+const testAccDataSourceTaikunKubernetesVersionsConfig = `
+data "taikun_kubernetes_versions" "all" {
+}
+
+data "taikun_kubernetes_versions" "latest" {
+  filter {
+    name   = "latest"
+    values = ["true"]
+  }
+}
+`
+
+func TestAccDataSourceTaikunKubernetesVersions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTaikunKubernetesVersionsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.taikun_kubernetes_versions.all", "latest_version"),
+					resource.TestCheckResourceAttr("data.taikun_kubernetes_versions.latest", "versions.#", "1"),
+					resource.TestCheckResourceAttrPair("data.taikun_kubernetes_versions.latest", "versions.0.version", "data.taikun_kubernetes_versions.all", "latest_version"),
+				),
+			},
+		},
+	})
+}
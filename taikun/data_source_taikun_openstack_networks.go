@@ -0,0 +1,84 @@
+package taikun
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/openstack"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func dataSourceTaikunOpenStackNetworks() *schema.Resource {
+	networksSchema := addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
+		"networks": {
+			Description: "List of retrieved OpenStack networks.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "OpenStack network ID.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "OpenStack network name.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}, "networks", "name")
+	// OpenStack network IDs are UUIDs, they do not follow the creation order
+	deleteFieldsFromSchema(networksSchema, "most_recent")
+
+	return &schema.Resource{
+		Description: "Retrieve the networks of an OpenStack project.",
+		ReadContext: dataSourceTaikunOpenStackNetworksRead,
+		Schema:      addOpenStackCredentialsArgumentsToDataSourceSchema(networksSchema),
+	}
+}
+
+func dataSourceTaikunOpenStackNetworksRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID, err := dataSourceTaikunOpenStackGetProjectID(d, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, password, applicationCredentialEnabled, err := dataSourceTaikunOpenStackGetAuth(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	body := &models.OpenStackNetworkListQuery{
+		ApplicationCredEnabled: applicationCredentialEnabled,
+		OpenStackDomain:        d.Get("domain").(string),
+		OpenStackPassword:      password,
+		OpenStackProjectID:     projectID,
+		OpenStackRegion:        d.Get("region").(string),
+		OpenStackURL:           d.Get("url").(string),
+		OpenStackUser:          user,
+	}
+	params := openstack.NewOpenstackNetworksParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.Openstack.OpenstackNetworks(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	networks := filter.apply(flattenTaikunOpenStackDropdown(response.Payload))
+
+	if err := d.Set("networks", networks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectID)
+	return nil
+}
@@ -0,0 +1,38 @@
+package taikun
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDataSourceTaikunOpenStackNetworksConfig = `
+data "taikun_openstack_networks" "all" {
+}
+
+data "taikun_openstack_networks" "public" {
+  filter {
+    name   = "name"
+    values = ["%s"]
+  }
+}
+`
+
+func TestAccDataSourceTaikunOpenStackNetworks(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceTaikunOpenStackNetworksConfig, os.Getenv("OS_INTERFACE")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.taikun_openstack_networks.all", "networks.#"),
+					resource.TestCheckResourceAttr("data.taikun_openstack_networks.public", "networks.#", "1"),
+					resource.TestCheckResourceAttr("data.taikun_openstack_networks.public", "networks.0.name", os.Getenv("OS_INTERFACE")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package taikun
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/openstack"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func dataSourceTaikunOpenStackSubnets() *schema.Resource {
+	subnetsSchema := addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
+		"subnets": {
+			Description: "List of retrieved OpenStack subnets.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "OpenStack subnet ID, can be used as `imported_network_subnet_id`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "OpenStack subnet name.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}, "subnets", "name")
+	// OpenStack subnet IDs are UUIDs, they do not follow the creation order
+	deleteFieldsFromSchema(subnetsSchema, "most_recent")
+
+	return &schema.Resource{
+		Description: "Retrieve the subnets of an OpenStack project.",
+		ReadContext: dataSourceTaikunOpenStackSubnetsRead,
+		Schema:      addOpenStackCredentialsArgumentsToDataSourceSchema(subnetsSchema),
+	}
+}
+
+func dataSourceTaikunOpenStackSubnetsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID, err := dataSourceTaikunOpenStackGetProjectID(d, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, password, applicationCredentialEnabled, err := dataSourceTaikunOpenStackGetAuth(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	body := &models.OpenstackSubnetListQuery{
+		ApplicationCredEnabled: applicationCredentialEnabled,
+		OpenStackDomain:        d.Get("domain").(string),
+		OpenStackPassword:      password,
+		OpenStackProject:       d.Get("project_name").(string),
+		OpenStackProjectID:     projectID,
+		OpenStackRegion:        d.Get("region").(string),
+		OpenStackURL:           d.Get("url").(string),
+		OpenStackUser:          user,
+	}
+	params := openstack.NewOpenstackSubnetsParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.Openstack.OpenstackSubnets(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnets := filter.apply(flattenTaikunOpenStackDropdown(response.Payload))
+
+	if err := d.Set("subnets", subnets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectID)
+	return nil
+}
@@ -0,0 +1,28 @@
+package taikun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDataSourceTaikunOpenStackSubnetsConfig = `
+data "taikun_openstack_subnets" "foo" {
+}
+`
+
+func TestAccDataSourceTaikunOpenStackSubnets(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTaikunOpenStackSubnetsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.taikun_openstack_subnets.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.taikun_openstack_subnets.foo", "subnets.#"),
+				),
+			},
+		},
+	})
+}
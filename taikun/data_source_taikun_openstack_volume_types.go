@@ -0,0 +1,79 @@
+package taikun
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/openstack"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func dataSourceTaikunOpenStackVolumeTypes() *schema.Resource {
+	volumeTypesSchema := addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
+		"volume_types": {
+			Description: "List of retrieved OpenStack volume types.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "OpenStack volume type name, can be used as `volume_type_name` or as the `volume_type` of a VM disk.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}, "volume_types", "name")
+	// OpenStack volume types have no ID
+	deleteFieldsFromSchema(volumeTypesSchema, "most_recent")
+
+	return &schema.Resource{
+		Description: "Retrieve the volume types of an OpenStack region.",
+		ReadContext: dataSourceTaikunOpenStackVolumeTypesRead,
+		Schema:      addOpenStackCredentialsArgumentsToDataSourceSchema(volumeTypesSchema),
+	}
+}
+
+func dataSourceTaikunOpenStackVolumeTypesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, password, applicationCredentialEnabled, err := dataSourceTaikunOpenStackGetAuth(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	body := &models.OpenstackVolumeTypeListQuery{
+		ApplicationCredEnabled: applicationCredentialEnabled,
+		OpenStackDomain:        d.Get("domain").(string),
+		OpenStackPassword:      password,
+		OpenStackRegion:        d.Get("region").(string),
+		OpenStackURL:           d.Get("url").(string),
+		OpenStackUser:          user,
+	}
+	params := openstack.NewOpenstackVolumeTypesParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.Openstack.OpenstackVolumeTypes(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	volumeTypes := make([]map[string]interface{}, len(response.Payload))
+	for i, volumeType := range response.Payload {
+		volumeTypes[i] = map[string]interface{}{
+			"name": volumeType,
+		}
+	}
+	volumeTypes = filter.apply(volumeTypes)
+
+	if err := d.Set("volume_types", volumeTypes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("region").(string))
+	return nil
+}
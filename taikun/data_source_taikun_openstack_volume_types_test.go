@@ -0,0 +1,29 @@
+package taikun
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDataSourceTaikunOpenStackVolumeTypesConfig = `
+data "taikun_openstack_volume_types" "foo" {
+}
+`
+
+func TestAccDataSourceTaikunOpenStackVolumeTypes(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTaikunOpenStackVolumeTypesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.taikun_openstack_volume_types.foo", "id", os.Getenv("OS_REGION_NAME")),
+					resource.TestCheckResourceAttrSet("data.taikun_openstack_volume_types.foo", "volume_types.#"),
+				),
+			},
+		},
+	})
+}
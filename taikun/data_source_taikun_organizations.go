@@ -15,7 +15,7 @@ func dataSourceTaikunOrganizations() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all organizations.",
 		ReadContext: dataSourceTaikunOrganizationsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organizations": {
 				Description: "List of retrieved organizations.",
 				Type:        schema.TypeList,
@@ -24,7 +24,7 @@ func dataSourceTaikunOrganizations() *schema.Resource {
 					Schema: dataSourceTaikunOrganizationSchema(),
 				},
 			},
-		},
+		}, "organizations", "name"),
 	}
 }
 
@@ -34,6 +34,12 @@ func dataSourceTaikunOrganizationsRead(_ context.Context, d *schema.ResourceData
 
 	params := organizations.NewOrganizationsListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	var rawOrganizationsList []*models.OrganizationDetailsDto
 	for {
 		response, err := apiClient.Client.Organizations.OrganizationsList(params, apiClient)
@@ -56,6 +62,8 @@ func dataSourceTaikunOrganizationsRead(_ context.Context, d *schema.ResourceData
 		organizationsList[i]["servers"] = rawOrganization.Servers
 		organizationsList[i]["users"] = rawOrganization.Users
 	}
+	organizationsList = filter.apply(organizationsList)
+
 	if err := d.Set("organizations", organizationsList); err != nil {
 		return diag.FromErr(err)
 	}
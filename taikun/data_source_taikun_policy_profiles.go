@@ -15,7 +15,7 @@ func dataSourceTaikunPolicyProfiles() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all Policy profiles.",
 		ReadContext: dataSourceTaikunPolicyProfilesRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organization_id": {
 				Description:      "Organization ID filter.",
 				Type:             schema.TypeString,
@@ -30,7 +30,7 @@ func dataSourceTaikunPolicyProfiles() *schema.Resource {
 					Schema: dataSourceTaikunPolicyProfileSchema(),
 				},
 			},
-		},
+		}, "policy_profiles", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunPolicyProfilesRead(_ context.Context, d *schema.ResourceDat
 
 	params := opa_profiles.NewOpaProfilesListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -68,6 +74,8 @@ func dataSourceTaikunPolicyProfilesRead(_ context.Context, d *schema.ResourceDat
 	for i, rawOPAProfile := range opaProfilesListDtos {
 		opaProfiles[i] = flattenTaikunPolicyProfile(rawOPAProfile)
 	}
+	opaProfiles = filter.apply(opaProfiles)
+
 	if err := d.Set("policy_profiles", opaProfiles); err != nil {
 		return diag.FromErr(err)
 	}
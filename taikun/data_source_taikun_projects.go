@@ -17,7 +17,7 @@ func dataSourceTaikunProjects() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all projects.",
 		ReadContext: dataSourceTaikunProjectsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organization_id": {
 				Description:      "Organization ID filter.",
 				Type:             schema.TypeString,
@@ -32,7 +32,7 @@ func dataSourceTaikunProjects() *schema.Resource {
 					Schema: dataSourceTaikunProjectSchema(),
 				},
 			},
-		},
+		}, "projects", "name"),
 	}
 }
 
@@ -42,6 +42,12 @@ func dataSourceTaikunProjectsRead(_ context.Context, d *schema.ResourceData, met
 
 	params := projects.NewProjectsListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	if organizationIDData, organizationIDProvided := d.GetOk("organization_id"); organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
 		organizationID, err := atoi32(dataSourceID)
@@ -91,6 +97,8 @@ func dataSourceTaikunProjectsRead(_ context.Context, d *schema.ResourceData, met
 
 		projects[i] = flattenTaikunProject(response.Payload.Project, response.Payload.Data, responseVM.Payload.Data, boundFlavorDTOs, boundImageDTOs, quotaResponse.Payload.Data[0])
 	}
+	projects = filter.apply(projects)
+
 	if err := d.Set("projects", projects); err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,7 @@ func dataSourceTaikunShowbackCredentials() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all showback credentials.",
 		ReadContext: dataSourceTaikunShowbackCredentialsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organization_id": {
 				Description:      "Organization ID filter.",
 				Type:             schema.TypeString,
@@ -29,7 +29,7 @@ func dataSourceTaikunShowbackCredentials() *schema.Resource {
 					Schema: dataSourceTaikunShowbackCredentialSchema(),
 				},
 			},
-		},
+		}, "showback_credentials", "name"),
 	}
 }
 
@@ -39,6 +39,11 @@ func dataSourceTaikunShowbackCredentialsRead(_ context.Context, d *schema.Resour
 
 	params := showback_credentials.NewShowbackCredentialsListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -67,6 +72,8 @@ func dataSourceTaikunShowbackCredentialsRead(_ context.Context, d *schema.Resour
 	for i, rawShowbackCredential := range showbackCredentialsList {
 		showbackCredentials[i] = flattenTaikunShowbackCredential(rawShowbackCredential)
 	}
+	showbackCredentials = filter.apply(showbackCredentials)
+
 	if err := d.Set("showback_credentials", showbackCredentials); err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,7 @@ func dataSourceTaikunShowbackRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all showback rules.",
 		ReadContext: dataSourceTaikunShowbackRulesRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organization_id": {
 				Description:      "Organization ID filter.",
 				Type:             schema.TypeString,
@@ -29,7 +29,7 @@ func dataSourceTaikunShowbackRules() *schema.Resource {
 					Schema: dataSourceTaikunShowbackRuleSchema(),
 				},
 			},
-		},
+		}, "showback_rules", "name"),
 	}
 }
 
@@ -39,6 +39,11 @@ func dataSourceTaikunShowbackRulesRead(_ context.Context, d *schema.ResourceData
 
 	params := showback_rules.NewShowbackRulesListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -67,6 +72,8 @@ func dataSourceTaikunShowbackRulesRead(_ context.Context, d *schema.ResourceData
 	for i, rawShowbackRule := range showbackRulesList {
 		showbackRules[i] = flattenTaikunShowbackRule(rawShowbackRule)
 	}
+	showbackRules = filter.apply(showbackRules)
+
 	if err := d.Set("showback_rules", showbackRules); err != nil {
 		return diag.FromErr(err)
 	}
@@ -15,7 +15,7 @@ func dataSourceTaikunSlackConfigurations() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all Slack configurations.",
 		ReadContext: dataSourceTaikunSlackConfigurationsRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organization_id": {
 				Description:      "Organization ID filter.",
 				Type:             schema.TypeString,
@@ -30,7 +30,7 @@ func dataSourceTaikunSlackConfigurations() *schema.Resource {
 					Schema: dataSourceTaikunSlackConfigurationSchema(),
 				},
 			},
-		},
+		}, "slack_configurations", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunSlackConfigurationsRead(_ context.Context, d *schema.Resour
 
 	params := slack.NewSlackListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -69,6 +75,8 @@ func dataSourceTaikunSlackConfigurationsRead(_ context.Context, d *schema.Resour
 		slackConfigurations[i] = flattenTaikunSlackConfiguration(rawSlackConfiguration)
 	}
 
+	slackConfigurations = filter.apply(slackConfigurations)
+
 	if err := d.Set("slack_configurations", slackConfigurations); err != nil {
 		return diag.FromErr(err)
 	}
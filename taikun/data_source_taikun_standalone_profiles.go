@@ -15,7 +15,7 @@ func dataSourceTaikunStandaloneProfiles() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all standalone profiles.",
 		ReadContext: dataSourceTaikunStandaloneProfilesRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organization_id": {
 				Description:      "Organization ID filter.",
 				Type:             schema.TypeString,
@@ -30,7 +30,7 @@ func dataSourceTaikunStandaloneProfiles() *schema.Resource {
 					Schema: dataSourceTaikunStandaloneProfileSchema(),
 				},
 			},
-		},
+		}, "standalone_profiles", "name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunStandaloneProfilesRead(_ context.Context, d *schema.Resourc
 
 	params := stand_alone_profile.NewStandAloneProfileListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -75,6 +81,8 @@ func dataSourceTaikunStandaloneProfilesRead(_ context.Context, d *schema.Resourc
 
 		standaloneProfiles[i] = flattenTaikunStandaloneProfile(rawStandaloneProfile, securityGroupResponse.GetPayload())
 	}
+	standaloneProfiles = filter.apply(standaloneProfiles)
+
 	if err := d.Set("standalone_profiles", standaloneProfiles); err != nil {
 		return diag.FromErr(err)
 	}
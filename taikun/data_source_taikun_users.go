@@ -15,7 +15,7 @@ func dataSourceTaikunUsers() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieve all users.",
 		ReadContext: dataSourceTaikunUsersRead,
-		Schema: map[string]*schema.Schema{
+		Schema: addFilterArgumentsToDataSourceSchema(map[string]*schema.Schema{
 			"organization_id": {
 				Description:      "Organization ID filter.",
 				Type:             schema.TypeString,
@@ -30,7 +30,7 @@ func dataSourceTaikunUsers() *schema.Resource {
 					Schema: dataSourceTaikunUserSchema(),
 				},
 			},
-		},
+		}, "users", "user_name"),
 	}
 }
 
@@ -40,6 +40,12 @@ func dataSourceTaikunUsersRead(_ context.Context, d *schema.ResourceData, meta i
 
 	params := users.NewUsersListParams().WithV(ApiVersion)
 
+	filter, err := newDataSourceFilter(d, "user_name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params = params.WithSearch(filter.search())
+
 	organizationIDData, organizationIDProvided := d.GetOk("organization_id")
 	if organizationIDProvided {
 		dataSourceID = organizationIDData.(string)
@@ -68,6 +74,8 @@ func dataSourceTaikunUsersRead(_ context.Context, d *schema.ResourceData, meta i
 	for i, rawUser := range rawUserList {
 		userList[i] = flattenTaikunUser(rawUser)
 	}
+	userList = filter.apply(userList)
+
 	if err := d.Set("users", userList); err != nil {
 		return diag.FromErr(err)
 	}
@@ -1,16 +1,21 @@
 package taikun
 
 import (
+	"crypto/rsa"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/mail"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/robfig/cron/v3"
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v2"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -112,6 +117,29 @@ func stringIsFilePath(i interface{}, path cty.Path) diag.Diagnostics {
 	return nil
 }
 
+func stringIsGCPServiceAccountKey(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.FromErr(path.NewErrorf("expected type to be string"))
+	}
+
+	var key map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &key); err != nil {
+		return diag.FromErr(path.NewErrorf("expected a JSON object: %s", err))
+	}
+
+	if key["type"] != "service_account" {
+		return diag.FromErr(path.NewErrorf("expected a service account key, \"type\" must be \"service_account\""))
+	}
+	for _, field := range []string{"project_id", "private_key"} {
+		if value, ok := key[field].(string); !ok || value == "" {
+			return diag.FromErr(path.NewErrorf("expected a service account key, \"%s\" must be set", field))
+		}
+	}
+
+	return nil
+}
+
 func stringIsCron(i interface{}, path cty.Path) diag.Diagnostics {
 	v, ok := i.(string)
 	if !ok {
@@ -126,6 +154,155 @@ func stringIsCron(i interface{}, path cty.Path) diag.Diagnostics {
 	return nil
 }
 
+// Headers of the user data formats handled by cloud-init, other than #cloud-config
+var cloudInitUserDataHeaders = []string{
+	"#!",
+	"#include",
+	"#cloud-boothook",
+	"#cloud-config-archive",
+	"#part-handler",
+	"#upstart-job",
+	"## template: jinja",
+	"Content-Type: multipart/",
+	"MIME-Version:",
+}
+
+// stringIsCloudInit checks the YAML of #cloud-config documents and warns
+// about user data whose format isn't recognised by cloud-init
+func stringIsCloudInit(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.FromErr(path.NewErrorf("expected type to be string"))
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	for _, header := range cloudInitUserDataHeaders {
+		if strings.HasPrefix(v, header) {
+			return nil
+		}
+	}
+
+	if !strings.HasPrefix(v, "#cloud-config") {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "Unrecognised cloud init format",
+			Detail:        "cloud-init user data usually starts with #cloud-config, #! or another header recognised by cloud-init, it may be ignored by the VM",
+			AttributePath: path,
+		}}
+	}
+
+	var document map[string]interface{}
+	if err := yaml.Unmarshal([]byte(v), &document); err != nil {
+		return diag.FromErr(path.NewErrorf("expected a valid #cloud-config YAML document: %s", err))
+	}
+
+	return nil
+}
+
+const sshPublicKeyMinRSABits = 2048
+
+var sshPublicKeyTypes = []string{
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoRSA,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoSKED25519,
+	ssh.KeyAlgoSKECDSA256,
+}
+
+// parseSSHPublicKey parses a single public key in the authorized_keys format
+// and returns it along with its comment
+func parseSSHPublicKey(publicKey string) (ssh.PublicKey, string, error) {
+	if strings.Contains(publicKey, "PRIVATE KEY") {
+		return nil, "", fmt.Errorf("expected a public key, not a private key")
+	}
+
+	key, comment, options, rest, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, "", fmt.Errorf("expected a public key in the OpenSSH format: %s", err)
+	}
+	if len(options) != 0 {
+		return nil, "", fmt.Errorf("expected a public key without options")
+	}
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, "", fmt.Errorf("expected a single public key")
+	}
+
+	return key, comment, nil
+}
+
+func stringIsSSHPublicKey(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.FromErr(path.NewErrorf("expected type to be string"))
+	}
+
+	key, _, err := parseSSHPublicKey(v)
+	if err != nil {
+		return diag.FromErr(path.NewErrorf("%s", err))
+	}
+
+	supported := false
+	for _, keyType := range sshPublicKeyTypes {
+		if key.Type() == keyType {
+			supported = true
+		}
+	}
+	if !supported {
+		return diag.FromErr(path.NewErrorf("expected one of the key types %s, got %s", strings.Join(sshPublicKeyTypes, ", "), key.Type()))
+	}
+
+	if key.Type() == ssh.KeyAlgoRSA {
+		rsaKey, ok := key.(ssh.CryptoPublicKey).CryptoPublicKey().(*rsa.PublicKey)
+		if !ok || rsaKey.N.BitLen() < sshPublicKeyMinRSABits {
+			return diag.FromErr(path.NewErrorf("expected an RSA key of at least %d bits", sshPublicKeyMinRSABits))
+		}
+	}
+
+	return nil
+}
+
+// normalizeSSHPublicKey formats a public key as "<type> <base64 key> <comment>"
+// with single spaces, leaving invalid keys untouched apart from the
+// surrounding whitespace
+func normalizeSSHPublicKey(publicKey string) string {
+	key, comment, err := parseSSHPublicKey(publicKey)
+	if err != nil {
+		return strings.TrimSpace(publicKey)
+	}
+
+	normalized := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+	if comment = strings.Join(strings.Fields(comment), " "); comment != "" {
+		normalized += " " + comment
+	}
+	return normalized
+}
+
+// sshPublicKeyDiffSuppressFunc ignores the whitespace and comment changes of
+// a public key, which Taikun doesn't use
+func sshPublicKeyDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
+	oldKey, _, oldErr := parseSSHPublicKey(old)
+	newKey, _, newErr := parseSSHPublicKey(new)
+	if oldErr != nil || newErr != nil {
+		return false
+	}
+	return string(oldKey.Marshal()) == string(newKey.Marshal())
+}
+
+// getSSHPublicKeyFingerprintAndType returns the SHA256 fingerprint and the
+// type of a public key, or empty strings if it can't be parsed
+func getSSHPublicKeyFingerprintAndType(publicKey string) (fingerprint string, keyType string) {
+	key, _, err := parseSSHPublicKey(publicKey)
+	if err != nil {
+		return "", ""
+	}
+	return ssh.FingerprintSHA256(key), key.Type()
+}
+
 func dateToDateTime(date string) strfmt.DateTime {
 	time, _ := time.Parse(time.RFC3339, dateToRfc3339DateTime(date))
 	return strfmt.DateTime(time)
@@ -343,3 +520,38 @@ func setResourceDataId(d *schema.ResourceData, id int32) {
 	idAsString := strconv.FormatInt(int64(id), 10)
 	d.SetId(idAsString)
 }
+
+// filterElementsByPreviousKeys keeps only the elements read from the API whose
+// key matches one of the previous elements, so that the elements managed by
+// other resources are ignored
+func filterElementsByPreviousKeys(elements []map[string]interface{}, previousData interface{}, key func(map[string]interface{}) string) []map[string]interface{} {
+	previousKeys := make(map[string]bool)
+	previousElements, _ := previousData.([]interface{})
+	for _, rawPreviousElement := range previousElements {
+		if previousElement, ok := rawPreviousElement.(map[string]interface{}); ok {
+			previousKeys[key(previousElement)] = true
+		}
+	}
+
+	filtered := make([]map[string]interface{}, 0, len(elements))
+	for _, element := range elements {
+		if previousKeys[key(element)] {
+			filtered = append(filtered, element)
+		}
+	}
+	return filtered
+}
+
+// keyedMutex serializes the operations sharing the same key, such as the
+// read-modify-write cycles on the sub-resources of a single Taikun resource
+type keyedMutex struct {
+	mutexes sync.Map
+}
+
+// Lock locks the mutex of the given key and returns the function unlocking it
+func (m *keyedMutex) Lock(key interface{}) func() {
+	value, _ := m.mutexes.LoadOrStore(key, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
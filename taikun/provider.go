@@ -71,14 +71,18 @@ func Provider() *schema.Provider {
 			"taikun_billing_credentials":         dataSourceTaikunBillingCredentials(),
 			"taikun_billing_rule":                dataSourceTaikunBillingRule(),
 			"taikun_billing_rules":               dataSourceTaikunBillingRules(),
+			"taikun_cloud_availability_zones":    dataSourceTaikunCloudAvailabilityZones(),
+			"taikun_cloud_credential":            dataSourceTaikunCloudCredential(),
 			"taikun_cloud_credential_aws":        dataSourceTaikunCloudCredentialAWS(),
 			"taikun_cloud_credential_azure":      dataSourceTaikunCloudCredentialAzure(),
 			"taikun_cloud_credential_gcp":        dataSourceTaikunCloudCredentialGCP(),
 			"taikun_cloud_credential_openstack":  dataSourceTaikunCloudCredentialOpenStack(),
+			"taikun_cloud_credentials":           dataSourceTaikunCloudCredentials(),
 			"taikun_cloud_credentials_aws":       dataSourceTaikunCloudCredentialsAWS(),
 			"taikun_cloud_credentials_azure":     dataSourceTaikunCloudCredentialsAzure(),
 			"taikun_cloud_credentials_gcp":       dataSourceTaikunCloudCredentialsGCP(),
 			"taikun_cloud_credentials_openstack": dataSourceTaikunCloudCredentialsOpenStack(),
+			"taikun_cloud_regions":               dataSourceTaikunCloudRegions(),
 			"taikun_flavors":                     dataSourceTaikunFlavors(),
 			"taikun_images":                      dataSourceTaikunImages(), // DEPRECATED
 			"taikun_images_aws":                  dataSourceTaikunImagesAWS(),
@@ -89,6 +93,10 @@ func Provider() *schema.Provider {
 			"taikun_kubeconfigs":                 dataSourceTaikunKubeconfigs(),
 			"taikun_kubernetes_profile":          dataSourceTaikunKubernetesProfile(),
 			"taikun_kubernetes_profiles":         dataSourceTaikunKubernetesProfiles(),
+			"taikun_kubernetes_versions":         dataSourceTaikunKubernetesVersions(),
+			"taikun_openstack_networks":          dataSourceTaikunOpenStackNetworks(),
+			"taikun_openstack_subnets":           dataSourceTaikunOpenStackSubnets(),
+			"taikun_openstack_volume_types":      dataSourceTaikunOpenStackVolumeTypes(),
 			"taikun_organization":                dataSourceTaikunOrganization(),
 			"taikun_organizations":               dataSourceTaikunOrganizations(),
 			"taikun_policy_profile":              dataSourceTaikunPolicyProfile(),
@@ -108,6 +116,10 @@ func Provider() *schema.Provider {
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"taikun_access_profile":                       resourceTaikunAccessProfile(),
+			"taikun_access_profile_allowed_host":          resourceTaikunAccessProfileAllowedHost(),
+			"taikun_access_profile_dns_server":            resourceTaikunAccessProfileDNSServer(),
+			"taikun_access_profile_ntp_server":            resourceTaikunAccessProfileNTPServer(),
+			"taikun_access_profile_ssh_user":              resourceTaikunAccessProfileSSHUser(),
 			"taikun_alerting_profile":                     resourceTaikunAlertingProfile(),
 			"taikun_backup_credential":                    resourceTaikunBackupCredential(),
 			"taikun_backup_policy":                        resourceTaikunBackupPolicy(),
@@ -119,10 +131,13 @@ func Provider() *schema.Provider {
 			"taikun_cloud_credential_openstack":           resourceTaikunCloudCredentialOpenStack(),
 			"taikun_kubeconfig":                           resourceTaikunKubeconfig(),
 			"taikun_kubernetes_profile":                   resourceTaikunKubernetesProfile(),
+			"taikun_notification_channel_microsoft_teams": resourceTaikunNotificationChannelMicrosoftTeams(),
+			"taikun_notification_channel_webhook":         resourceTaikunNotificationChannelWebhook(),
 			"taikun_organization_billing_rule_attachment": resourceTaikunOrganizationBillingRuleAttachment(),
 			"taikun_organization":                         resourceTaikunOrganization(),
 			"taikun_policy_profile":                       resourceTaikunPolicyProfile(),
 			"taikun_project":                              resourceTaikunProject(),
+			"taikun_project_alerting_profile_attachment":  resourceTaikunProjectAlertingProfileAttachment(),
 			"taikun_project_user_attachment":              resourceTaikunProjectUserAttachment(),
 			"taikun_showback_credential":                  resourceTaikunShowbackCredential(),
 			"taikun_showback_rule":                        resourceTaikunShowbackRule(),
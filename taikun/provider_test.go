@@ -54,15 +54,21 @@ func testAccPreCheckPrometheus(t *testing.T) {
 }
 
 func testAccPreCheckOpenStack(t *testing.T) {
-	if err := os.Getenv("OS_AUTH_URL"); err == "" {
-		t.Fatal("OS_AUTH_URL must be set for acceptance tests")
-	}
+	testAccPreCheckOpenStackProject(t)
 	if err := os.Getenv("OS_USERNAME"); err == "" {
 		t.Fatal("OS_USERNAME must be set for acceptance tests")
 	}
 	if err := os.Getenv("OS_PASSWORD"); err == "" {
 		t.Fatal("OS_PASSWORD must be set for acceptance tests")
 	}
+}
+
+// testAccPreCheckOpenStackProject checks the OpenStack settings shared by
+// both authentication methods
+func testAccPreCheckOpenStackProject(t *testing.T) {
+	if err := os.Getenv("OS_AUTH_URL"); err == "" {
+		t.Fatal("OS_AUTH_URL must be set for acceptance tests")
+	}
 	if err := os.Getenv("OS_USER_DOMAIN_NAME"); err == "" {
 		t.Fatal("OS_USER_DOMAIN_NAME must be set for acceptance tests")
 	}
@@ -77,6 +83,16 @@ func testAccPreCheckOpenStack(t *testing.T) {
 	}
 }
 
+func testAccPreCheckOpenStackApplicationCredential(t *testing.T) {
+	testAccPreCheckOpenStackProject(t)
+	if err := os.Getenv("OS_APPLICATION_CREDENTIAL_ID"); err == "" {
+		t.Fatal("OS_APPLICATION_CREDENTIAL_ID must be set for acceptance tests")
+	}
+	if err := os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET"); err == "" {
+		t.Fatal("OS_APPLICATION_CREDENTIAL_SECRET must be set for acceptance tests")
+	}
+}
+
 func testAccPreCheckAWS(t *testing.T) {
 	if err := os.Getenv("AWS_ACCESS_KEY_ID"); err == "" {
 		t.Fatal("AWS_ACCESS_KEY_ID must be set for acceptance tests")
@@ -2,8 +2,15 @@ package taikun
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,9 +27,10 @@ import (
 func resourceTaikunAccessProfileSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"allowed_host": {
-			Description: "List of allowed hosts.",
-			Type:        schema.TypeList,
-			Optional:    true,
+			Description:      "List of allowed hosts.",
+			Type:             schema.TypeList,
+			Optional:         true,
+			DiffSuppressFunc: accessProfileUndeclaredElementsDiffSuppressFunc("allowed_host"),
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"description": {
@@ -56,10 +64,11 @@ func resourceTaikunAccessProfileSchema() map[string]*schema.Schema {
 			Computed:    true,
 		},
 		"dns_server": {
-			Description: "List of DNS servers.",
-			Type:        schema.TypeList,
-			Optional:    true,
-			MaxItems:    2,
+			Description:      "List of DNS servers, in order of priority.",
+			Type:             schema.TypeList,
+			Optional:         true,
+			DiffSuppressFunc: accessProfileUndeclaredElementsDiffSuppressFunc("dns_server"),
+			MaxItems:         2,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"address": {
@@ -76,10 +85,39 @@ func resourceTaikunAccessProfileSchema() map[string]*schema.Schema {
 			},
 		},
 		"http_proxy": {
-			Description:  "HTTP proxy of the access profile.",
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description: "HTTP proxy of the access profile.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"password": {
+						Description:  "Password used to authenticate with the HTTP proxy.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"http_proxy.0.username"},
+					},
+					"url": {
+						Description: "URL of the HTTP proxy, without credentials.",
+						Type:        schema.TypeString,
+						Required:    true,
+						ValidateFunc: validation.All(
+							validation.IsURLWithHTTPorHTTPS,
+							validation.StringDoesNotMatch(
+								regexp.MustCompile("^[^/]*//[^/]*@"),
+								"expected a URL without credentials, use username and password instead",
+							),
+						),
+					},
+					"username": {
+						Description: "Username used to authenticate with the HTTP proxy.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
 		},
 		"id": {
 			Description: "The ID of the access profile.",
@@ -109,11 +147,18 @@ func resourceTaikunAccessProfileSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringLenBetween(3, 30),
 			ForceNew:     true,
 		},
-		"ntp_server": {
-			Description: "List of NTP servers.",
-			Type:        schema.TypeList,
+		"non_exclusive_sub_resources": {
+			Description: "Whether to manage only the allowed hosts, DNS servers, NTP servers and SSH users declared in the access profile, leaving the others to the `taikun_access_profile_allowed_host`, `taikun_access_profile_dns_server`, `taikun_access_profile_ntp_server` and `taikun_access_profile_ssh_user` resources. Enabling it leaves the elements which are not declared in the access profile untouched, the declared ones cannot change in the same apply.",
+			Type:        schema.TypeBool,
 			Optional:    true,
-			MaxItems:    2,
+			Default:     false,
+		},
+		"ntp_server": {
+			Description:      "List of NTP servers, in order of priority.",
+			Type:             schema.TypeList,
+			Optional:         true,
+			DiffSuppressFunc: accessProfileUndeclaredElementsDiffSuppressFunc("ntp_server"),
+			MaxItems:         2,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"address": {
@@ -142,16 +187,27 @@ func resourceTaikunAccessProfileSchema() map[string]*schema.Schema {
 			Computed:    true,
 		},
 		"ssh_user": {
-			Description: "List of SSH users.",
-			Type:        schema.TypeList,
-			Optional:    true,
+			Description:      "List of SSH users.",
+			Type:             schema.TypeList,
+			Optional:         true,
+			DiffSuppressFunc: accessProfileUndeclaredElementsDiffSuppressFunc("ssh_user"),
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
+					"fingerprint_sha256": {
+						Description: "SHA256 fingerprint of the SSH user's public key.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
 					"id": {
 						Description: "ID of the SSH user.",
 						Type:        schema.TypeString,
 						Computed:    true,
 					},
+					"key_type": {
+						Description: "Type of the SSH user's public key, for example `ssh-ed25519`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
 					"name": {
 						Description: "Name of the SSH user.",
 						Type:        schema.TypeString,
@@ -166,10 +222,11 @@ func resourceTaikunAccessProfileSchema() map[string]*schema.Schema {
 						),
 					},
 					"public_key": {
-						Description:  "Public key of the SSH user.",
-						Type:         schema.TypeString,
-						Required:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						Description:      "Public key of the SSH user, in the OpenSSH format. The supported key types are ed25519, RSA of at least 2048 bits, ECDSA and their security key (`sk-`) variants. Whitespace and comment changes are ignored.",
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: stringIsSSHPublicKey,
+						DiffSuppressFunc: sshPublicKeyDiffSuppressFunc,
 					},
 				},
 			},
@@ -188,6 +245,15 @@ func resourceTaikunAccessProfile() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceTaikunAccessProfileCustomizeDiffNonExclusive,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceTaikunAccessProfileV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceTaikunAccessProfileStateUpgradeV0,
+				Version: 0,
+			},
+		},
 	}
 }
 
@@ -201,9 +267,7 @@ func resourceTaikunAccessProfileCreate(ctx context.Context, d *schema.ResourceDa
 	if organizationIDData, organizationIDIsSet := d.GetOk("organization_id"); organizationIDIsSet {
 		body.OrganizationID, _ = atoi32(organizationIDData.(string))
 	}
-	if proxy, isProxySet := d.GetOk("http_proxy"); isProxySet {
-		body.HTTPProxy = proxy.(string)
-	}
+	body.HTTPProxy = getAccessProfileHttpProxy(d)
 
 	resourceTaikunAccessProfileCreateAllowedHosts(d, body)
 	resourceTaikunAccessProfileCreateDnsServers(d, body)
@@ -279,7 +343,7 @@ func resourceTaikunAccessProfileCreateSshUsers(d *schema.ResourceData, body *mod
 			sshUser := rawSshUser.(map[string]interface{})
 			body.SSHUsers[i] = &models.SSHUserCreateDto{
 				Name:         sshUser["name"].(string),
-				SSHPublicKey: sshUser["public_key"].(string),
+				SSHPublicKey: normalizeSSHPublicKey(sshUser["public_key"].(string)),
 			}
 		}
 	}
@@ -342,7 +406,22 @@ func generateResourceTaikunAccessProfileRead(withRetries bool) schema.ReadContex
 
 		rawAccessProfile := response.GetPayload().Data[0]
 
-		err = setResourceDataFromMap(d, flattenTaikunAccessProfile(rawAccessProfile, sshResponse))
+		accessProfileMap := flattenTaikunAccessProfile(rawAccessProfile, sshResponse)
+		// The access profile data sources share this read function
+		nonExclusive, _ := d.Get("non_exclusive_sub_resources").(bool)
+		for attribute, key := range accessProfileElementKeys {
+			elements := accessProfileMap[attribute].([]map[string]interface{})
+			if nonExclusive {
+				elements = filterElementsByPreviousKeys(elements, d.Get(attribute), key)
+			}
+			// The order of the DNS and NTP servers is their priority, it is kept as read
+			if !accessProfilePrioritizedElements[attribute] {
+				elements = orderAccessProfileElements(elements, d.Get(attribute), key)
+			}
+			accessProfileMap[attribute] = elements
+		}
+
+		err = setResourceDataFromMap(d, accessProfileMap)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -354,9 +433,8 @@ func generateResourceTaikunAccessProfileRead(withRetries bool) schema.ReadContex
 }
 
 // Update an access profile resource.
-// If there have been changes to the allowed hosts, DNS servers, NTP servers or
-// SSH users, these will be deleted and recreated as there is no easy way to
-// tell which of them are new and which have been modified.
+// Only the allowed hosts, DNS servers, NTP servers and SSH users which have
+// been added, modified or removed are sent to the API.
 func resourceTaikunAccessProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiClient := meta.(*taikungoclient.Client)
 
@@ -397,6 +475,16 @@ func resourceTaikunAccessProfileUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	// The undeclared elements were hidden from the plan, stop reading them
+	if accessProfileEnablesNonExclusive(d.GetChange) {
+		for attribute, key := range accessProfileElementKeys {
+			declaredData := keepDeclaredAccessProfileElements(d.Get(attribute), accessProfileConfigElements(d, attribute), key)
+			if err := d.Set(attribute, declaredData); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return readAfterUpdateWithRetries(generateResourceTaikunAccessProfileReadWithRetries(), ctx, d, meta)
 }
 
@@ -405,41 +493,352 @@ func resourceTaikunAccessProfileUpdateHttpProxy(d *schema.ResourceData, id int32
 	if d.HasChange("http_proxy") {
 		body := models.UpdateAccessProfileDto{
 			Name:      d.Get("name").(string),
-			HTTPProxy: "",
+			HTTPProxy: getAccessProfileHttpProxy(d),
 		}
 
-		if newHttpProxy, newHttpProxyIsSet := d.GetOk("http_proxy"); newHttpProxyIsSet {
-			body.HTTPProxy = newHttpProxy.(string)
+		params := access_profiles.NewAccessProfilesUpdateParams().WithV(ApiVersion).WithID(id).WithBody(&body)
+		_, err = apiClient.Client.AccessProfiles.AccessProfilesUpdate(params, apiClient, withExplicitHttpProxy(body))
+	}
+	return
+}
+
+// withExplicitHttpProxy sends the update's httpProxy even when it is empty.
+// The generated DTO omits an empty httpProxy, so removing the http_proxy block
+// would otherwise not reach Taikun.
+func withExplicitHttpProxy(body models.UpdateAccessProfileDto) access_profiles.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		params := op.Params
+		op.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, registry strfmt.Registry) error {
+			if err := params.WriteToRequest(r, registry); err != nil {
+				return err
+			}
+			return r.SetBodyParam(map[string]string{
+				"name":      body.Name,
+				"httpProxy": body.HTTPProxy,
+			})
+		})
+	}
+}
+
+// getAccessProfileHttpProxy returns the proxy URL sent to Taikun, which holds
+// the proxy's credentials as its user info
+func getAccessProfileHttpProxy(d *schema.ResourceData) string {
+	rawHttpProxies := d.Get("http_proxy").([]interface{})
+	if len(rawHttpProxies) == 0 || rawHttpProxies[0] == nil {
+		return ""
+	}
+	httpProxy := rawHttpProxies[0].(map[string]interface{})
+
+	httpProxyURL, err := url.Parse(httpProxy["url"].(string))
+	if err != nil {
+		return httpProxy["url"].(string)
+	}
+	if username := httpProxy["username"].(string); username != "" {
+		if password := httpProxy["password"].(string); password != "" {
+			httpProxyURL.User = url.UserPassword(username, password)
+		} else {
+			httpProxyURL.User = url.User(username)
 		}
+	}
+	return httpProxyURL.String()
+}
 
-		params := access_profiles.NewAccessProfilesUpdateParams().WithV(ApiVersion).WithID(id)
-		_, err = apiClient.Client.AccessProfiles.AccessProfilesUpdate(params, apiClient)
+// flattenTaikunAccessProfileHttpProxy splits the proxy URL returned by Taikun
+// into its URL and credentials
+func flattenTaikunAccessProfileHttpProxy(rawHttpProxy string) []map[string]interface{} {
+	if rawHttpProxy == "" {
+		return []map[string]interface{}{}
 	}
-	return
+
+	httpProxy := map[string]interface{}{
+		"password": "",
+		"url":      rawHttpProxy,
+		"username": "",
+	}
+	httpProxyURL, err := url.Parse(rawHttpProxy)
+	if err != nil || httpProxyURL.User == nil {
+		return []map[string]interface{}{httpProxy}
+	}
+
+	httpProxy["username"] = httpProxyURL.User.Username()
+	httpProxy["password"], _ = httpProxyURL.User.Password()
+	httpProxyURL.User = nil
+	httpProxy["url"] = httpProxyURL.String()
+	return []map[string]interface{}{httpProxy}
+}
+
+// accessProfileElementKeys identify the allowed hosts, DNS servers, NTP
+// servers and SSH users of an access profile
+var accessProfileElementKeys = map[string]func(map[string]interface{}) string{
+	"allowed_host": func(allowedHost map[string]interface{}) string {
+		return fmt.Sprintf("%s/%d", allowedHost["address"], allowedHost["mask_bits"])
+	},
+	"dns_server": func(dnsServer map[string]interface{}) string {
+		return dnsServer["address"].(string)
+	},
+	"ntp_server": func(ntpServer map[string]interface{}) string {
+		return ntpServer["address"].(string)
+	},
+	"ssh_user": func(sshUser map[string]interface{}) string {
+		return sshUser["name"].(string)
+	},
+}
+
+// accessProfilePrioritizedElements are the lists whose order matters, the
+// first server being the primary one
+var accessProfilePrioritizedElements = map[string]bool{
+	"dns_server": true,
+	"ntp_server": true,
 }
 
-// Update the access profile's allowed hosts
+// orderAccessProfileElements sorts the elements read from the API in the
+// order of the previous elements with the same key, followed by the others
+// in the API's order. Since reordering the elements does not update them,
+// this prevents a reordered list from showing a diff after every refresh.
+func orderAccessProfileElements(elements []map[string]interface{}, previousData interface{}, key func(map[string]interface{}) string) []map[string]interface{} {
+	positions := make(map[string]int)
+	previousElements, _ := previousData.([]interface{})
+	for i, rawPreviousElement := range previousElements {
+		if previousElement, ok := rawPreviousElement.(map[string]interface{}); ok {
+			if _, found := positions[key(previousElement)]; !found {
+				positions[key(previousElement)] = i
+			}
+		}
+	}
+
+	ordered := make([]map[string]interface{}, len(elements))
+	copy(ordered, elements)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		iPosition, iFound := positions[key(ordered[i])]
+		jPosition, jFound := positions[key(ordered[j])]
+		if iFound && jFound {
+			return iPosition < jPosition
+		}
+		return iFound && !jFound
+	})
+	return ordered
+}
+
+// accessProfileElementFields are the fields of the allowed hosts and SSH users
+// which can be updated without replacing them
+var accessProfileElementFields = map[string][]string{
+	"allowed_host": {"description"},
+	"ssh_user":     {"public_key"},
+}
+
+// accessProfileEnablesNonExclusive tells whether non_exclusive_sub_resources
+// is being enabled
+func accessProfileEnablesNonExclusive(getChange func(key string) (interface{}, interface{})) bool {
+	oldNonExclusive, newNonExclusive := getChange("non_exclusive_sub_resources")
+	oldValue, _ := oldNonExclusive.(bool)
+	newValue, _ := newNonExclusive.(bool)
+	return !oldValue && newValue
+}
+
+// accessProfileUndeclaredElementsDiffSuppressFunc hides the elements which are
+// not declared in the access profile when non_exclusive_sub_resources is
+// enabled. They may belong to the standalone access profile resources, so they
+// are left untouched rather than removed, see resourceTaikunAccessProfileUpdate.
+func accessProfileUndeclaredElementsDiffSuppressFunc(attribute string) schema.SchemaDiffSuppressFunc {
+	return func(_, _, _ string, d *schema.ResourceData) bool {
+		if !accessProfileEnablesNonExclusive(d.GetChange) {
+			return false
+		}
+		oldData, newData := d.GetChange(attribute)
+		declaredData := keepDeclaredAccessProfileElements(oldData, newData, accessProfileElementKeys[attribute])
+		return accessProfileElementsMatch(attribute, declaredData, newData)
+	}
+}
+
+// accessProfileElementsMatch tells whether updating the old elements to the
+// new ones would leave them untouched
+func accessProfileElementsMatch(attribute string, oldData interface{}, newData interface{}) bool {
+	if accessProfilePrioritizedElements[attribute] {
+		diff := diffAccessProfileServers(oldData, newData)
+		return len(diff.removed) == 0 && diff.replaced == nil && len(diff.added) == 0
+	}
+	diff := diffAccessProfileElements(oldData, newData, accessProfileElementKeys[attribute], accessProfileElementFields[attribute]...)
+	return len(diff.added) == 0 && len(diff.changed) == 0 && len(diff.removed) == 0
+}
+
+// resourceTaikunAccessProfileCustomizeDiffNonExclusive rejects the changes to
+// the declared elements while non_exclusive_sub_resources is being enabled,
+// since the plan would show the undeclared elements as removed
+func resourceTaikunAccessProfileCustomizeDiffNonExclusive(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !accessProfileEnablesNonExclusive(d.GetChange) {
+		return nil
+	}
+	for attribute, key := range accessProfileElementKeys {
+		oldData, newData := d.GetChange(attribute)
+		declaredData := keepDeclaredAccessProfileElements(oldData, newData, key)
+		// Without undeclared elements, the plan shows the changes as they are
+		if len(declaredData) != len(oldData.([]interface{})) && !accessProfileElementsMatch(attribute, declaredData, newData) {
+			return fmt.Errorf("the %s blocks cannot change while non_exclusive_sub_resources is being enabled, since the elements they do not declare would show as removed: enable non_exclusive_sub_resources first", attribute)
+		}
+	}
+	return nil
+}
+
+// accessProfileConfigElements returns the elements of the given attribute
+// declared in the configuration, with their string and number fields
+func accessProfileConfigElements(d *schema.ResourceData, attribute string) []interface{} {
+	elements := make([]interface{}, 0)
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return elements
+	}
+	rawElements := rawConfig.GetAttr(attribute)
+	if rawElements.IsNull() || !rawElements.IsKnown() {
+		return elements
+	}
+	for it := rawElements.ElementIterator(); it.Next(); {
+		_, rawElement := it.Element()
+		element := make(map[string]interface{})
+		for name, value := range rawElement.AsValueMap() {
+			if value.IsNull() || !value.IsKnown() {
+				continue
+			}
+			switch value.Type() {
+			case cty.String:
+				element[name] = value.AsString()
+			case cty.Number:
+				number, _ := value.AsBigFloat().Int64()
+				element[name] = int(number)
+			}
+		}
+		elements = append(elements, element)
+	}
+	return elements
+}
+
+// keepDeclaredAccessProfileElements returns the old elements whose key
+// matches one of the new elements
+func keepDeclaredAccessProfileElements(oldData interface{}, newData interface{}, key func(map[string]interface{}) string) []interface{} {
+	oldElements := make([]map[string]interface{}, 0)
+	for _, rawOldElement := range oldData.([]interface{}) {
+		oldElements = append(oldElements, rawOldElement.(map[string]interface{}))
+	}
+
+	kept := filterElementsByPreviousKeys(oldElements, newData, key)
+	keptData := make([]interface{}, len(kept))
+	for i, element := range kept {
+		keptData[i] = element
+	}
+	return keptData
+}
+
+// accessProfileElementsDiff holds the changes to the allowed hosts, DNS
+// servers, NTP servers or SSH users of an access profile
+type accessProfileElementsDiff struct {
+	added []map[string]interface{}
+	// New values of the changed elements, with the ID of the old element
+	changed []map[string]interface{}
+	removed []map[string]interface{}
+}
+
+// accessProfileServersDiff holds the changes to the DNS or NTP servers of an
+// access profile, to be applied in order: removed, replaced, then added
+type accessProfileServersDiff struct {
+	removed []map[string]interface{}
+	// New value of the first misplaced server, with the ID of the old server
+	replaced map[string]interface{}
+	added    []map[string]interface{}
+}
+
+// diffAccessProfileServers compares the old and new servers by position.
+// Since Taikun lists the servers in the order they were added, every server
+// from the first misplaced one onwards is rewritten: the first one is edited
+// in place and the following ones are removed and added again. The access
+// profile thus keeps at least one server and never exceeds its limit.
+func diffAccessProfileServers(oldData interface{}, newData interface{}) accessProfileServersDiff {
+	var diff accessProfileServersDiff
+
+	oldServers := oldData.([]interface{})
+	newServers := newData.([]interface{})
+	p := 0
+	for p < len(oldServers) && p < len(newServers) && oldServers[p].(map[string]interface{})["address"] == newServers[p].(map[string]interface{})["address"] {
+		p++
+	}
+
+	for i := p; i < len(oldServers); i++ {
+		if i != p || p == len(newServers) {
+			diff.removed = append(diff.removed, oldServers[i].(map[string]interface{}))
+		}
+	}
+	for i := p; i < len(newServers); i++ {
+		newServer := newServers[i].(map[string]interface{})
+		if i == p && p < len(oldServers) {
+			diff.replaced = map[string]interface{}{
+				"address": newServer["address"],
+				"id":      oldServers[p].(map[string]interface{})["id"],
+			}
+		} else {
+			diff.added = append(diff.added, newServer)
+		}
+	}
+
+	return diff
+}
+
+// diffAccessProfileElements matches the old and new elements of a list by
+// key, the elements with the same key whose fields differ are changed
+func diffAccessProfileElements(oldData interface{}, newData interface{}, key func(map[string]interface{}) string, fields ...string) accessProfileElementsDiff {
+	var diff accessProfileElementsDiff
+
+	oldElements := oldData.([]interface{})
+	oldIndexesByKey := make(map[string][]int)
+	for i, rawOldElement := range oldElements {
+		k := key(rawOldElement.(map[string]interface{}))
+		oldIndexesByKey[k] = append(oldIndexesByKey[k], i)
+	}
+
+	matched := make([]bool, len(oldElements))
+	for _, rawNewElement := range newData.([]interface{}) {
+		newElement := rawNewElement.(map[string]interface{})
+		k := key(newElement)
+		if len(oldIndexesByKey[k]) == 0 {
+			diff.added = append(diff.added, newElement)
+			continue
+		}
+		oldIndex := oldIndexesByKey[k][0]
+		oldIndexesByKey[k] = oldIndexesByKey[k][1:]
+		matched[oldIndex] = true
+		oldElement := oldElements[oldIndex].(map[string]interface{})
+		for _, field := range fields {
+			if oldElement[field] != newElement[field] {
+				changedElement := make(map[string]interface{}, len(newElement))
+				for field, value := range newElement {
+					changedElement[field] = value
+				}
+				changedElement["id"] = oldElement["id"]
+				diff.changed = append(diff.changed, changedElement)
+				break
+			}
+		}
+	}
+
+	for i, rawOldElement := range oldElements {
+		if !matched[i] {
+			diff.removed = append(diff.removed, rawOldElement.(map[string]interface{}))
+		}
+	}
+
+	return diff
+}
+
+// Update the access profile's allowed hosts, keyed by CIDR.
+// The new hosts are allowed before the old ones are removed so that access
+// to the servers is never fully removed.
 func resourceTaikunAccessProfileUpdateAllowedHosts(d *schema.ResourceData, accessProfileId int32, apiClient *taikungoclient.Client) (err error) {
 	if !d.HasChange("allowed_host") {
 		return
 	}
 
-	// Delete old allowed hosts
 	oldAllowedHostData, newAllowedHostData := d.GetChange("allowed_host")
-	oldAllowedHosts := oldAllowedHostData.([]interface{})
-	for _, rawOldAllowedHost := range oldAllowedHosts {
-		oldAllowedHost := rawOldAllowedHost.(map[string]interface{})
-		id, _ := atoi32(oldAllowedHost["id"].(string))
-		params := allowed_host.NewAllowedHostDeleteParams().WithV(ApiVersion).WithID(id)
-		if _, _, err = apiClient.Client.AllowedHost.AllowedHostDelete(params, apiClient); err != nil {
-			return
-		}
-	}
+	diff := diffAccessProfileElements(oldAllowedHostData, newAllowedHostData, accessProfileElementKeys["allowed_host"], accessProfileElementFields["allowed_host"]...)
 
 	// Add new allowed hosts
-	newAllowedHosts := newAllowedHostData.([]interface{})
-	for _, rawNewAllowedHost := range newAllowedHosts {
-		newAllowedHost := rawNewAllowedHost.(map[string]interface{})
+	for _, newAllowedHost := range diff.added {
 		body := models.CreateAllowedHostCommand{
 			AccessProfileID: accessProfileId,
 			Description:     newAllowedHost["description"].(string),
@@ -452,20 +851,45 @@ func resourceTaikunAccessProfileUpdateAllowedHosts(d *schema.ResourceData, acces
 		}
 	}
 
+	// Edit changed allowed hosts
+	for _, changedAllowedHost := range diff.changed {
+		id, _ := atoi32(changedAllowedHost["id"].(string))
+		body := models.EditAllowedHostDto{
+			Description: changedAllowedHost["description"].(string),
+			IPAddress:   changedAllowedHost["address"].(string),
+			MaskBits:    int32(changedAllowedHost["mask_bits"].(int)),
+		}
+		params := allowed_host.NewAllowedHostEditParams().WithV(ApiVersion).WithID(id).WithBody(&body)
+		if _, err = apiClient.Client.AllowedHost.AllowedHostEdit(params, apiClient); err != nil {
+			return
+		}
+	}
+
+	// Delete old allowed hosts
+	for _, oldAllowedHost := range diff.removed {
+		id, _ := atoi32(oldAllowedHost["id"].(string))
+		params := allowed_host.NewAllowedHostDeleteParams().WithV(ApiVersion).WithID(id)
+		if _, _, err = apiClient.Client.AllowedHost.AllowedHostDelete(params, apiClient); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
-// Update the access profile's DNS servers
+// Update the access profile's DNS servers.
+// The servers are kept in the order of their priority, see
+// diffAccessProfileServers.
 func resourceTaikunAccessProfileUpdateDnsServers(d *schema.ResourceData, accessProfileId int32, apiClient *taikungoclient.Client) (err error) {
 	if !d.HasChange("dns_server") {
 		return
 	}
 
-	// Delete old servers
 	oldDnsServerData, newDnsServerData := d.GetChange("dns_server")
-	oldDnsServers := oldDnsServerData.([]interface{})
-	for _, rawOldDnsServer := range oldDnsServers {
-		oldDnsServer := rawOldDnsServer.(map[string]interface{})
+	diff := diffAccessProfileServers(oldDnsServerData, newDnsServerData)
+
+	// Delete old servers
+	for _, oldDnsServer := range diff.removed {
 		id, _ := atoi32(oldDnsServer["id"].(string))
 		params := dns_servers.NewDNSServersDeleteParams().WithV(ApiVersion).WithID(id)
 		if _, _, err = apiClient.Client.DNSServers.DNSServersDelete(params, apiClient); err != nil {
@@ -473,10 +897,20 @@ func resourceTaikunAccessProfileUpdateDnsServers(d *schema.ResourceData, accessP
 		}
 	}
 
+	// Replace the first misplaced server in place
+	if diff.replaced != nil {
+		id, _ := atoi32(diff.replaced["id"].(string))
+		body := models.DNSNtpAddressEditDto{
+			Address: diff.replaced["address"].(string),
+		}
+		params := dns_servers.NewDNSServersEditParams().WithV(ApiVersion).WithID(id).WithBody(&body)
+		if _, err = apiClient.Client.DNSServers.DNSServersEdit(params, apiClient); err != nil {
+			return
+		}
+	}
+
 	// Add new servers
-	newDnsServers := newDnsServerData.([]interface{})
-	for _, rawNewDnsServer := range newDnsServers {
-		newDnsServer := rawNewDnsServer.(map[string]interface{})
+	for _, newDnsServer := range diff.added {
 		body := models.CreateDNSServerCommand{
 			AccessProfileID: accessProfileId,
 			Address:         newDnsServer["address"].(string),
@@ -490,17 +924,19 @@ func resourceTaikunAccessProfileUpdateDnsServers(d *schema.ResourceData, accessP
 	return
 }
 
-// Update the access profile's NTP servers
+// Update the access profile's NTP servers.
+// The servers are kept in the order of their priority, see
+// diffAccessProfileServers.
 func resourceTaikunAccessProfileUpdateNtpServers(d *schema.ResourceData, accessProfileId int32, apiClient *taikungoclient.Client) (err error) {
 	if !d.HasChange("ntp_server") {
 		return
 	}
 
-	// Delete old servers
 	oldNtpServerData, newNtpServerData := d.GetChange("ntp_server")
-	oldNtpServers := oldNtpServerData.([]interface{})
-	for _, rawOldNtpServer := range oldNtpServers {
-		oldNtpServer := rawOldNtpServer.(map[string]interface{})
+	diff := diffAccessProfileServers(oldNtpServerData, newNtpServerData)
+
+	// Delete old servers
+	for _, oldNtpServer := range diff.removed {
 		id, _ := atoi32(oldNtpServer["id"].(string))
 		params := ntp_servers.NewNtpServersDeleteParams().WithV(ApiVersion).WithID(id)
 		if _, _, err = apiClient.Client.NtpServers.NtpServersDelete(params, apiClient); err != nil {
@@ -508,10 +944,20 @@ func resourceTaikunAccessProfileUpdateNtpServers(d *schema.ResourceData, accessP
 		}
 	}
 
+	// Replace the first misplaced server in place
+	if diff.replaced != nil {
+		id, _ := atoi32(diff.replaced["id"].(string))
+		body := models.DNSNtpAddressEditDto{
+			Address: diff.replaced["address"].(string),
+		}
+		params := ntp_servers.NewNtpServersEditParams().WithV(ApiVersion).WithID(id).WithBody(&body)
+		if _, err = apiClient.Client.NtpServers.NtpServersEdit(params, apiClient); err != nil {
+			return
+		}
+	}
+
 	// Add new servers
-	newNtpServers := newNtpServerData.([]interface{})
-	for _, rawNewNtpServer := range newNtpServers {
-		newNtpServer := rawNewNtpServer.(map[string]interface{})
+	for _, newNtpServer := range diff.added {
 		body := models.CreateNtpServerCommand{
 			AccessProfileID: accessProfileId,
 			Address:         newNtpServer["address"].(string),
@@ -525,32 +971,23 @@ func resourceTaikunAccessProfileUpdateNtpServers(d *schema.ResourceData, accessP
 	return
 }
 
-// Update the access profile's SSH users
+// Update the access profile's SSH users, keyed by name.
+// The new users are added and the changed keys replaced before the old users
+// are removed so that access to the servers is never fully removed.
 func resourceTaikunAccessProfileUpdateSshUsers(d *schema.ResourceData, accessProfileId int32, apiClient *taikungoclient.Client) (err error) {
 	if !d.HasChange("ssh_user") {
 		return
 	}
 
-	// Delete old SSH users
 	oldSshUserData, newSshUserData := d.GetChange("ssh_user")
-	oldSshUsers := oldSshUserData.([]interface{})
-	for _, rawOldSshUser := range oldSshUsers {
-		oldSshUser := rawOldSshUser.(map[string]interface{})
-		id, _ := atoi32(oldSshUser["id"].(string))
-		params := ssh_users.NewSSHUsersDeleteParams().WithV(ApiVersion).WithBody(&models.DeleteSSHUserCommand{ID: id})
-		if _, err = apiClient.Client.SSHUsers.SSHUsersDelete(params, apiClient); err != nil {
-			return
-		}
-	}
+	diff := diffAccessProfileElements(oldSshUserData, newSshUserData, accessProfileElementKeys["ssh_user"], accessProfileElementFields["ssh_user"]...)
 
 	// Add new SSH users
-	newSshUsers := newSshUserData.([]interface{})
-	for _, rawNewSshUser := range newSshUsers {
-		newSshUser := rawNewSshUser.(map[string]interface{})
+	for _, newSshUser := range diff.added {
 		body := models.CreateSSHUserCommand{
 			AccessProfileID: accessProfileId,
 			Name:            newSshUser["name"].(string),
-			SSHPublicKey:    newSshUser["public_key"].(string),
+			SSHPublicKey:    normalizeSSHPublicKey(newSshUser["public_key"].(string)),
 		}
 		params := ssh_users.NewSSHUsersCreateParams().WithV(ApiVersion).WithBody(&body)
 		if _, err = apiClient.Client.SSHUsers.SSHUsersCreate(params, apiClient); err != nil {
@@ -558,6 +995,30 @@ func resourceTaikunAccessProfileUpdateSshUsers(d *schema.ResourceData, accessPro
 		}
 	}
 
+	// Edit changed SSH users
+	for _, changedSshUser := range diff.changed {
+		id, _ := atoi32(changedSshUser["id"].(string))
+		body := models.EditSSHUserCommand{
+			AccessProfileID: accessProfileId,
+			ID:              id,
+			Name:            changedSshUser["name"].(string),
+			SSHPublicKey:    normalizeSSHPublicKey(changedSshUser["public_key"].(string)),
+		}
+		params := ssh_users.NewSSHUsersEditParams().WithV(ApiVersion).WithBody(&body)
+		if _, err = apiClient.Client.SSHUsers.SSHUsersEdit(params, apiClient); err != nil {
+			return
+		}
+	}
+
+	// Delete old SSH users
+	for _, oldSshUser := range diff.removed {
+		id, _ := atoi32(oldSshUser["id"].(string))
+		params := ssh_users.NewSSHUsersDeleteParams().WithV(ApiVersion).WithBody(&models.DeleteSSHUserCommand{ID: id})
+		if _, err = apiClient.Client.SSHUsers.SSHUsersDelete(params, apiClient); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
@@ -608,10 +1069,13 @@ func flattenTaikunAccessProfile(rawAccessProfile *models.AccessProfilesListDto,
 
 	SSHUsers := make([]map[string]interface{}, len(sshResponse.Payload))
 	for i, rawSSHUser := range sshResponse.Payload {
+		fingerprint, keyType := getSSHPublicKeyFingerprintAndType(rawSSHUser.SSHPublicKey)
 		SSHUsers[i] = map[string]interface{}{
-			"id":         i32toa(rawSSHUser.ID),
-			"name":       rawSSHUser.Name,
-			"public_key": rawSSHUser.SSHPublicKey,
+			"fingerprint_sha256": fingerprint,
+			"id":                 i32toa(rawSSHUser.ID),
+			"key_type":           keyType,
+			"name":               rawSSHUser.Name,
+			"public_key":         rawSSHUser.SSHPublicKey,
 		}
 	}
 
@@ -619,7 +1083,7 @@ func flattenTaikunAccessProfile(rawAccessProfile *models.AccessProfilesListDto,
 		"allowed_host":      AllowedHosts,
 		"created_by":        rawAccessProfile.CreatedBy,
 		"dns_server":        DNSServers,
-		"http_proxy":        rawAccessProfile.HTTPProxy,
+		"http_proxy":        flattenTaikunAccessProfileHttpProxy(rawAccessProfile.HTTPProxy),
 		"id":                i32toa(rawAccessProfile.ID),
 		"last_modified":     rawAccessProfile.LastModified,
 		"last_modified_by":  rawAccessProfile.LastModifiedBy,
@@ -641,3 +1105,51 @@ func resourceTaikunAccessProfileLock(id int32, lock bool, apiClient *taikungocli
 	_, err := apiClient.Client.AccessProfiles.AccessProfilesLockManager(params, apiClient)
 	return err
 }
+
+func accessProfileElementAccessProfileIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:      "ID of the access profile.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: stringIsInt,
+	}
+}
+
+// parseAccessProfileElementId parses the ID of the allowed hosts, DNS servers,
+// NTP servers and SSH users managed outside of their access profile
+func parseAccessProfileElementId(id string, resourceName string) (int32, int32, error) {
+	list := strings.Split(id, "/")
+	if len(list) != 2 {
+		return 0, 0, fmt.Errorf("unable to determine %s ID", resourceName)
+	}
+
+	accessProfileId, err := atoi32(list[0])
+	elementId, err2 := atoi32(list[1])
+	if err != nil || err2 != nil {
+		return 0, 0, fmt.Errorf("unable to determine %s ID", resourceName)
+	}
+
+	return accessProfileId, elementId, nil
+}
+
+// resourceTaikunAccessProfileV0 is the access profile resource before
+// http_proxy became a block
+func resourceTaikunAccessProfileV0() *schema.Resource {
+	accessProfileSchema := resourceTaikunAccessProfileSchema()
+	accessProfileSchema["http_proxy"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return &schema.Resource{
+		Schema: accessProfileSchema,
+	}
+}
+
+// resourceTaikunAccessProfileStateUpgradeV0 converts the http_proxy string of
+// the version 0 state into a block
+func resourceTaikunAccessProfileStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	httpProxy, _ := rawState["http_proxy"].(string)
+	rawState["http_proxy"] = flattenTaikunAccessProfileHttpProxy(httpProxy)
+	return rawState, nil
+}
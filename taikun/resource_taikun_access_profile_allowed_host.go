@@ -0,0 +1,155 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/allowed_host"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func resourceTaikunAccessProfileAllowedHostSchema() map[string]*schema.Schema {
+	allowedHostSchema := resourceTaikunAccessProfileSchema()["allowed_host"].Elem.(*schema.Resource).Schema
+	deleteFieldsFromSchema(allowedHostSchema, "id")
+	allowedHostSchema["access_profile_id"] = accessProfileElementAccessProfileIDSchema()
+	return allowedHostSchema
+}
+
+func resourceTaikunAccessProfileAllowedHost() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Taikun Access Profile - Allowed Host",
+		CreateContext: resourceTaikunAccessProfileAllowedHostCreate,
+		ReadContext:   generateResourceTaikunAccessProfileAllowedHostReadWithoutRetries(),
+		UpdateContext: resourceTaikunAccessProfileAllowedHostUpdate,
+		DeleteContext: resourceTaikunAccessProfileAllowedHostDelete,
+		Schema:        resourceTaikunAccessProfileAllowedHostSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaikunAccessProfileAllowedHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	accessProfileId, err := atoi32(d.Get("access_profile_id").(string))
+	if err != nil {
+		return diag.Errorf("access_profile_id isn't valid: %s", d.Get("access_profile_id").(string))
+	}
+
+	body := &models.CreateAllowedHostCommand{
+		AccessProfileID: accessProfileId,
+		Description:     d.Get("description").(string),
+		IPAddress:       d.Get("address").(string),
+		MaskBits:        int32(d.Get("mask_bits").(int)),
+	}
+	params := allowed_host.NewAllowedHostCreateParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.AllowedHost.AllowedHostCreate(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", accessProfileId, response.Payload.ID))
+
+	return readAfterCreateWithRetries(generateResourceTaikunAccessProfileAllowedHostReadWithRetries(), ctx, d, meta)
+}
+
+func generateResourceTaikunAccessProfileAllowedHostReadWithRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileAllowedHostRead(true)
+}
+func generateResourceTaikunAccessProfileAllowedHostReadWithoutRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileAllowedHostRead(false)
+}
+func generateResourceTaikunAccessProfileAllowedHostRead(withRetries bool) schema.ReadContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		apiClient := meta.(*taikungoclient.Client)
+
+		id := d.Id()
+		d.SetId("")
+		accessProfileId, allowedHostId, err := parseAccessProfileElementId(id, "taikun_access_profile_allowed_host")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		params := allowed_host.NewAllowedHostListParams().WithV(ApiVersion).WithAccessProfileID(accessProfileId)
+		var rawAllowedHosts []*models.AllowedHostListDto
+		for {
+			response, err := apiClient.Client.AllowedHost.AllowedHostList(params, apiClient)
+			if err != nil {
+				if _, ok := err.(*allowed_host.AllowedHostListNotFound); ok && !withRetries {
+					return nil
+				}
+				return diag.FromErr(err)
+			}
+			rawAllowedHosts = append(rawAllowedHosts, response.Payload.Data...)
+			if len(response.Payload.Data) == 0 || len(rawAllowedHosts) >= int(response.Payload.TotalCount) {
+				break
+			}
+			offset := int32(len(rawAllowedHosts))
+			params = params.WithOffset(&offset)
+		}
+
+		for _, rawAllowedHost := range rawAllowedHosts {
+			if rawAllowedHost.ID == allowedHostId {
+				err := setResourceDataFromMap(d, map[string]interface{}{
+					"access_profile_id": i32toa(accessProfileId),
+					"address":           rawAllowedHost.IPAddress,
+					"description":       rawAllowedHost.Description,
+					"mask_bits":         rawAllowedHost.MaskBits,
+				})
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.SetId(id)
+				return nil
+			}
+		}
+
+		if withRetries {
+			d.SetId(id)
+			return diag.Errorf(notFoundAfterCreateOrUpdateError)
+		}
+		return nil
+	}
+}
+
+func resourceTaikunAccessProfileAllowedHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, allowedHostId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_allowed_host")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := &models.EditAllowedHostDto{
+		Description: d.Get("description").(string),
+		IPAddress:   d.Get("address").(string),
+		MaskBits:    int32(d.Get("mask_bits").(int)),
+	}
+	params := allowed_host.NewAllowedHostEditParams().WithV(ApiVersion).WithID(allowedHostId).WithBody(body)
+	if _, err := apiClient.Client.AllowedHost.AllowedHostEdit(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAfterUpdateWithRetries(generateResourceTaikunAccessProfileAllowedHostReadWithRetries(), ctx, d, meta)
+}
+
+func resourceTaikunAccessProfileAllowedHostDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, allowedHostId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_allowed_host")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := allowed_host.NewAllowedHostDeleteParams().WithV(ApiVersion).WithID(allowedHostId)
+	if _, _, err := apiClient.Client.AllowedHost.AllowedHostDelete(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
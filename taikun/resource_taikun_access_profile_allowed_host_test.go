@@ -0,0 +1,66 @@
+package taikun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccResourceTaikunAccessProfileAllowedHostConfig = `
+resource "taikun_access_profile" "foo" {
+  name                        = "%s"
+  non_exclusive_sub_resources = true
+
+  allowed_host {
+    description = "Host A"
+    address     = "10.0.0.1"
+    mask_bits   = 8
+  }
+}
+
+resource "taikun_access_profile_allowed_host" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  description       = "%s"
+  address           = "172.19.42.2"
+  mask_bits         = %d
+}
+`
+
+func TestAccResourceTaikunAccessProfileAllowedHost(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAccessProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileAllowedHostConfig, name, "Host B", 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "allowed_host.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "allowed_host.0.address", "10.0.0.1"),
+					resource.TestCheckResourceAttrPair("taikun_access_profile_allowed_host.foo", "access_profile_id", "taikun_access_profile.foo", "id"),
+					resource.TestCheckResourceAttr("taikun_access_profile_allowed_host.foo", "description", "Host B"),
+					resource.TestCheckResourceAttr("taikun_access_profile_allowed_host.foo", "address", "172.19.42.2"),
+					resource.TestCheckResourceAttr("taikun_access_profile_allowed_host.foo", "mask_bits", "24"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileAllowedHostConfig, name, "Host C", 16),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "allowed_host.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile_allowed_host.foo", "description", "Host C"),
+					resource.TestCheckResourceAttr("taikun_access_profile_allowed_host.foo", "mask_bits", "16"),
+				),
+			},
+			{
+				ResourceName:      "taikun_access_profile_allowed_host.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
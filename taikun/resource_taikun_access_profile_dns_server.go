@@ -0,0 +1,140 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/dns_servers"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func resourceTaikunAccessProfileDNSServerSchema() map[string]*schema.Schema {
+	dnsServerSchema := resourceTaikunAccessProfileSchema()["dns_server"].Elem.(*schema.Resource).Schema
+	deleteFieldsFromSchema(dnsServerSchema, "id")
+	dnsServerSchema["access_profile_id"] = accessProfileElementAccessProfileIDSchema()
+	return dnsServerSchema
+}
+
+func resourceTaikunAccessProfileDNSServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Taikun Access Profile - DNS Server",
+		CreateContext: resourceTaikunAccessProfileDNSServerCreate,
+		ReadContext:   generateResourceTaikunAccessProfileDNSServerReadWithoutRetries(),
+		UpdateContext: resourceTaikunAccessProfileDNSServerUpdate,
+		DeleteContext: resourceTaikunAccessProfileDNSServerDelete,
+		Schema:        resourceTaikunAccessProfileDNSServerSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaikunAccessProfileDNSServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	accessProfileId, err := atoi32(d.Get("access_profile_id").(string))
+	if err != nil {
+		return diag.Errorf("access_profile_id isn't valid: %s", d.Get("access_profile_id").(string))
+	}
+
+	body := &models.CreateDNSServerCommand{
+		AccessProfileID: accessProfileId,
+		Address:         d.Get("address").(string),
+	}
+	params := dns_servers.NewDNSServersCreateParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.DNSServers.DNSServersCreate(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", accessProfileId, response.Payload.ID))
+
+	return readAfterCreateWithRetries(generateResourceTaikunAccessProfileDNSServerReadWithRetries(), ctx, d, meta)
+}
+
+func generateResourceTaikunAccessProfileDNSServerReadWithRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileDNSServerRead(true)
+}
+func generateResourceTaikunAccessProfileDNSServerReadWithoutRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileDNSServerRead(false)
+}
+func generateResourceTaikunAccessProfileDNSServerRead(withRetries bool) schema.ReadContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		apiClient := meta.(*taikungoclient.Client)
+
+		id := d.Id()
+		d.SetId("")
+		accessProfileId, dnsServerId, err := parseAccessProfileElementId(id, "taikun_access_profile_dns_server")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		params := dns_servers.NewDNSServersListParams().WithV(ApiVersion).WithAccessProfileID(accessProfileId)
+		response, err := apiClient.Client.DNSServers.DNSServersList(params, apiClient)
+		if err != nil {
+			if _, ok := err.(*dns_servers.DNSServersListNotFound); ok && !withRetries {
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		for _, rawDNSServer := range response.Payload {
+			if rawDNSServer.ID == dnsServerId {
+				err := setResourceDataFromMap(d, map[string]interface{}{
+					"access_profile_id": i32toa(accessProfileId),
+					"address":           rawDNSServer.Address,
+				})
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.SetId(id)
+				return nil
+			}
+		}
+
+		if withRetries {
+			d.SetId(id)
+			return diag.Errorf(notFoundAfterCreateOrUpdateError)
+		}
+		return nil
+	}
+}
+
+func resourceTaikunAccessProfileDNSServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, dnsServerId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_dns_server")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := &models.DNSNtpAddressEditDto{
+		Address: d.Get("address").(string),
+	}
+	params := dns_servers.NewDNSServersEditParams().WithV(ApiVersion).WithID(dnsServerId).WithBody(body)
+	if _, err := apiClient.Client.DNSServers.DNSServersEdit(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAfterUpdateWithRetries(generateResourceTaikunAccessProfileDNSServerReadWithRetries(), ctx, d, meta)
+}
+
+func resourceTaikunAccessProfileDNSServerDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, dnsServerId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_dns_server")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := dns_servers.NewDNSServersDeleteParams().WithV(ApiVersion).WithID(dnsServerId)
+	if _, _, err := apiClient.Client.DNSServers.DNSServersDelete(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
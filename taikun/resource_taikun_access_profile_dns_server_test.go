@@ -0,0 +1,102 @@
+package taikun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccResourceTaikunAccessProfileDNSServerConfig = `
+resource "taikun_access_profile" "foo" {
+  name                        = "%s"
+  non_exclusive_sub_resources = true
+
+  dns_server {
+    address = "8.8.8.8"
+  }
+}
+
+resource "taikun_access_profile_dns_server" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  address           = "%s"
+}
+`
+
+func TestAccResourceTaikunAccessProfileDNSServer(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAccessProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileDNSServerConfig, name, "8.8.4.4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "dns_server.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "dns_server.0.address", "8.8.8.8"),
+					resource.TestCheckResourceAttrPair("taikun_access_profile_dns_server.foo", "access_profile_id", "taikun_access_profile.foo", "id"),
+					resource.TestCheckResourceAttr("taikun_access_profile_dns_server.foo", "address", "8.8.4.4"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileDNSServerConfig, name, "1.1.1.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "dns_server.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile_dns_server.foo", "address", "1.1.1.1"),
+				),
+			},
+			{
+				ResourceName:      "taikun_access_profile_dns_server.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceTaikunAccessProfileDNSServerEnableNonExclusiveConfig = `
+resource "taikun_access_profile" "foo" {
+  name                        = "%s"
+  non_exclusive_sub_resources = %t
+
+  dns_server {
+    address = "8.8.8.8"
+  }
+}
+
+resource "taikun_access_profile_dns_server" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  address           = "8.8.4.4"
+}
+`
+
+func TestAccResourceTaikunAccessProfileDNSServerEnableNonExclusive(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAccessProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The exclusive access profile reads the DNS server of the standalone resource
+				Config:             fmt.Sprintf(testAccResourceTaikunAccessProfileDNSServerEnableNonExclusiveConfig, name, false),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Enabling non_exclusive_sub_resources must not remove it
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileDNSServerEnableNonExclusiveConfig, name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "dns_server.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "dns_server.0.address", "8.8.8.8"),
+					resource.TestCheckResourceAttr("taikun_access_profile_dns_server.foo", "address", "8.8.4.4"),
+				),
+			},
+		},
+	})
+}
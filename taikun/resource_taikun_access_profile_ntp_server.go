@@ -0,0 +1,140 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/ntp_servers"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func resourceTaikunAccessProfileNTPServerSchema() map[string]*schema.Schema {
+	ntpServerSchema := resourceTaikunAccessProfileSchema()["ntp_server"].Elem.(*schema.Resource).Schema
+	deleteFieldsFromSchema(ntpServerSchema, "id")
+	ntpServerSchema["access_profile_id"] = accessProfileElementAccessProfileIDSchema()
+	return ntpServerSchema
+}
+
+func resourceTaikunAccessProfileNTPServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Taikun Access Profile - NTP Server",
+		CreateContext: resourceTaikunAccessProfileNTPServerCreate,
+		ReadContext:   generateResourceTaikunAccessProfileNTPServerReadWithoutRetries(),
+		UpdateContext: resourceTaikunAccessProfileNTPServerUpdate,
+		DeleteContext: resourceTaikunAccessProfileNTPServerDelete,
+		Schema:        resourceTaikunAccessProfileNTPServerSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaikunAccessProfileNTPServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	accessProfileId, err := atoi32(d.Get("access_profile_id").(string))
+	if err != nil {
+		return diag.Errorf("access_profile_id isn't valid: %s", d.Get("access_profile_id").(string))
+	}
+
+	body := &models.CreateNtpServerCommand{
+		AccessProfileID: accessProfileId,
+		Address:         d.Get("address").(string),
+	}
+	params := ntp_servers.NewNtpServersCreateParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.NtpServers.NtpServersCreate(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", accessProfileId, response.Payload.ID))
+
+	return readAfterCreateWithRetries(generateResourceTaikunAccessProfileNTPServerReadWithRetries(), ctx, d, meta)
+}
+
+func generateResourceTaikunAccessProfileNTPServerReadWithRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileNTPServerRead(true)
+}
+func generateResourceTaikunAccessProfileNTPServerReadWithoutRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileNTPServerRead(false)
+}
+func generateResourceTaikunAccessProfileNTPServerRead(withRetries bool) schema.ReadContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		apiClient := meta.(*taikungoclient.Client)
+
+		id := d.Id()
+		d.SetId("")
+		accessProfileId, ntpServerId, err := parseAccessProfileElementId(id, "taikun_access_profile_ntp_server")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		params := ntp_servers.NewNtpServersListParams().WithV(ApiVersion).WithAccessProfileID(accessProfileId)
+		response, err := apiClient.Client.NtpServers.NtpServersList(params, apiClient)
+		if err != nil {
+			if _, ok := err.(*ntp_servers.NtpServersListNotFound); ok && !withRetries {
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		for _, rawNTPServer := range response.Payload {
+			if rawNTPServer.ID == ntpServerId {
+				err := setResourceDataFromMap(d, map[string]interface{}{
+					"access_profile_id": i32toa(accessProfileId),
+					"address":           rawNTPServer.Address,
+				})
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.SetId(id)
+				return nil
+			}
+		}
+
+		if withRetries {
+			d.SetId(id)
+			return diag.Errorf(notFoundAfterCreateOrUpdateError)
+		}
+		return nil
+	}
+}
+
+func resourceTaikunAccessProfileNTPServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, ntpServerId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_ntp_server")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := &models.DNSNtpAddressEditDto{
+		Address: d.Get("address").(string),
+	}
+	params := ntp_servers.NewNtpServersEditParams().WithV(ApiVersion).WithID(ntpServerId).WithBody(body)
+	if _, err := apiClient.Client.NtpServers.NtpServersEdit(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAfterUpdateWithRetries(generateResourceTaikunAccessProfileNTPServerReadWithRetries(), ctx, d, meta)
+}
+
+func resourceTaikunAccessProfileNTPServerDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, ntpServerId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_ntp_server")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := ntp_servers.NewNtpServersDeleteParams().WithV(ApiVersion).WithID(ntpServerId)
+	if _, _, err := apiClient.Client.NtpServers.NtpServersDelete(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
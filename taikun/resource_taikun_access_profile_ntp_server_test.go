@@ -0,0 +1,59 @@
+package taikun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccResourceTaikunAccessProfileNTPServerConfig = `
+resource "taikun_access_profile" "foo" {
+  name                        = "%s"
+  non_exclusive_sub_resources = true
+
+  ntp_server {
+    address = "time.windows.com"
+  }
+}
+
+resource "taikun_access_profile_ntp_server" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  address           = "%s"
+}
+`
+
+func TestAccResourceTaikunAccessProfileNTPServer(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAccessProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileNTPServerConfig, name, "ntp.pool.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "ntp_server.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "ntp_server.0.address", "time.windows.com"),
+					resource.TestCheckResourceAttrPair("taikun_access_profile_ntp_server.foo", "access_profile_id", "taikun_access_profile.foo", "id"),
+					resource.TestCheckResourceAttr("taikun_access_profile_ntp_server.foo", "address", "ntp.pool.org"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileNTPServerConfig, name, "time.google.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "ntp_server.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile_ntp_server.foo", "address", "time.google.com"),
+				),
+			},
+			{
+				ResourceName:      "taikun_access_profile_ntp_server.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/ssh_users"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func resourceTaikunAccessProfileSSHUserSchema() map[string]*schema.Schema {
+	sshUserSchema := resourceTaikunAccessProfileSchema()["ssh_user"].Elem.(*schema.Resource).Schema
+	deleteFieldsFromSchema(sshUserSchema, "id")
+	sshUserSchema["access_profile_id"] = accessProfileElementAccessProfileIDSchema()
+	return sshUserSchema
+}
+
+func resourceTaikunAccessProfileSSHUser() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Taikun Access Profile - SSH User",
+		CreateContext: resourceTaikunAccessProfileSSHUserCreate,
+		ReadContext:   generateResourceTaikunAccessProfileSSHUserReadWithoutRetries(),
+		UpdateContext: resourceTaikunAccessProfileSSHUserUpdate,
+		DeleteContext: resourceTaikunAccessProfileSSHUserDelete,
+		Schema:        resourceTaikunAccessProfileSSHUserSchema(),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("fingerprint_sha256", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+				return d.HasChange("public_key")
+			}),
+			customdiff.ComputedIf("key_type", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+				return d.HasChange("public_key")
+			}),
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaikunAccessProfileSSHUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	accessProfileId, err := atoi32(d.Get("access_profile_id").(string))
+	if err != nil {
+		return diag.Errorf("access_profile_id isn't valid: %s", d.Get("access_profile_id").(string))
+	}
+
+	body := &models.CreateSSHUserCommand{
+		AccessProfileID: accessProfileId,
+		Name:            d.Get("name").(string),
+		SSHPublicKey:    normalizeSSHPublicKey(d.Get("public_key").(string)),
+	}
+	params := ssh_users.NewSSHUsersCreateParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.SSHUsers.SSHUsersCreate(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", accessProfileId, response.Payload.ID))
+
+	return readAfterCreateWithRetries(generateResourceTaikunAccessProfileSSHUserReadWithRetries(), ctx, d, meta)
+}
+
+func generateResourceTaikunAccessProfileSSHUserReadWithRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileSSHUserRead(true)
+}
+func generateResourceTaikunAccessProfileSSHUserReadWithoutRetries() schema.ReadContextFunc {
+	return generateResourceTaikunAccessProfileSSHUserRead(false)
+}
+func generateResourceTaikunAccessProfileSSHUserRead(withRetries bool) schema.ReadContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		apiClient := meta.(*taikungoclient.Client)
+
+		id := d.Id()
+		d.SetId("")
+		accessProfileId, sshUserId, err := parseAccessProfileElementId(id, "taikun_access_profile_ssh_user")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		params := ssh_users.NewSSHUsersListParams().WithV(ApiVersion).WithAccessProfileID(accessProfileId)
+		response, err := apiClient.Client.SSHUsers.SSHUsersList(params, apiClient)
+		if err != nil {
+			if _, ok := err.(*ssh_users.SSHUsersListNotFound); ok && !withRetries {
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		for _, rawSSHUser := range response.Payload {
+			if rawSSHUser.ID == sshUserId {
+				fingerprint, keyType := getSSHPublicKeyFingerprintAndType(rawSSHUser.SSHPublicKey)
+				err := setResourceDataFromMap(d, map[string]interface{}{
+					"access_profile_id":  i32toa(accessProfileId),
+					"fingerprint_sha256": fingerprint,
+					"key_type":           keyType,
+					"name":               rawSSHUser.Name,
+					"public_key":         rawSSHUser.SSHPublicKey,
+				})
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.SetId(id)
+				return nil
+			}
+		}
+
+		if withRetries {
+			d.SetId(id)
+			return diag.Errorf(notFoundAfterCreateOrUpdateError)
+		}
+		return nil
+	}
+}
+
+func resourceTaikunAccessProfileSSHUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	accessProfileId, sshUserId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_ssh_user")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := &models.EditSSHUserCommand{
+		AccessProfileID: accessProfileId,
+		ID:              sshUserId,
+		Name:            d.Get("name").(string),
+		SSHPublicKey:    normalizeSSHPublicKey(d.Get("public_key").(string)),
+	}
+	params := ssh_users.NewSSHUsersEditParams().WithV(ApiVersion).WithBody(body)
+	if _, err := apiClient.Client.SSHUsers.SSHUsersEdit(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAfterUpdateWithRetries(generateResourceTaikunAccessProfileSSHUserReadWithRetries(), ctx, d, meta)
+}
+
+func resourceTaikunAccessProfileSSHUserDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, sshUserId, err := parseAccessProfileElementId(d.Id(), "taikun_access_profile_ssh_user")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := ssh_users.NewSSHUsersDeleteParams().WithV(ApiVersion).WithBody(&models.DeleteSSHUserCommand{ID: sshUserId})
+	if _, err := apiClient.Client.SSHUsers.SSHUsersDelete(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,67 @@
+package taikun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccResourceTaikunAccessProfileSSHUserConfig = `
+resource "taikun_access_profile" "foo" {
+  name                        = "%s"
+  non_exclusive_sub_resources = true
+
+  ssh_user {
+    name       = "oui_oui"
+    public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGQwGpzLk0IzqKnBpaHqecLA+X4zfHamNe9Rg3CoaXHF :oui_oui:"
+  }
+}
+
+resource "taikun_access_profile_ssh_user" "foo" {
+  access_profile_id = resource.taikun_access_profile.foo.id
+  name              = "%s"
+  public_key        = "%s"
+}
+`
+
+func TestAccResourceTaikunAccessProfileSSHUser(t *testing.T) {
+	name := randomTestName()
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGQwGpzLk0IzqKnBpaHqecLA+X4zfHamNe9Rg3CoaXHF :non_non:"
+	newPublicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPD2q/QCbGFJtMRtIXirJuy+0XT/IrmKR9pcTM83ZXBQ :non_non:"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAccessProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileSSHUserConfig, name, "non_non", publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "ssh_user.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "ssh_user.0.name", "oui_oui"),
+					resource.TestCheckResourceAttrPair("taikun_access_profile_ssh_user.foo", "access_profile_id", "taikun_access_profile.foo", "id"),
+					resource.TestCheckResourceAttr("taikun_access_profile_ssh_user.foo", "name", "non_non"),
+					resource.TestCheckResourceAttr("taikun_access_profile_ssh_user.foo", "public_key", publicKey),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunAccessProfileSSHUserConfig, name, "non_non", newPublicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAccessProfileExists,
+					resource.TestCheckResourceAttr("taikun_access_profile.foo", "ssh_user.#", "1"),
+					resource.TestCheckResourceAttr("taikun_access_profile_ssh_user.foo", "name", "non_non"),
+					resource.TestCheckResourceAttr("taikun_access_profile_ssh_user.foo", "public_key", newPublicKey),
+					resource.TestCheckResourceAttr("taikun_access_profile_ssh_user.foo", "key_type", "ssh-ed25519"),
+					resource.TestCheckResourceAttr("taikun_access_profile_ssh_user.foo", "fingerprint_sha256", "SHA256:VRjYVEZdobsN3e8hLQd1t5cpYXyAM5euq3THg6db3SM"),
+				),
+			},
+			{
+				ResourceName:      "taikun_access_profile_ssh_user.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
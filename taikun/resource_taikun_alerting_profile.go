@@ -2,6 +2,7 @@ package taikun
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,12 @@ import (
 
 func resourceTaikunAlertingProfileSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
+		"attached_project_ids": {
+			Description: "IDs of the projects to which the alerting profile is attached.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
 		"created_by": {
 			Description: "The creator of the alerting profile.",
 			Type:        schema.TypeString,
@@ -43,9 +50,10 @@ func resourceTaikunAlertingProfileSchema() map[string]*schema.Schema {
 						Computed:    true,
 					},
 					"token": {
-						Description: "The token (required by Opsgenie, Pagerduty and Splunk).",
+						Description: "The token (required by Opsgenie, Pagerduty and Splunk). Write-only: changes made outside of Terraform are not detected.",
 						Type:        schema.TypeString,
 						Optional:    true,
+						Sensitive:   true,
 						Default:     "",
 					},
 					"type": {
@@ -90,6 +98,12 @@ func resourceTaikunAlertingProfileSchema() map[string]*schema.Schema {
 			Required:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		"non_exclusive_sub_resources": {
+			Description: "Whether to manage only the integrations and webhooks declared in the alerting profile, leaving the others to the `taikun_notification_channel_microsoft_teams` and `taikun_notification_channel_webhook` resources.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 		"organization_id": {
 			Description:      "The ID of the organization which owns the profile.",
 			Type:             schema.TypeString,
@@ -143,9 +157,10 @@ func resourceTaikunAlertingProfileSchema() map[string]*schema.Schema {
 									Required:    true,
 								},
 								"value": {
-									Description: "The header value.",
+									Description: "The header value. Write-only: changes made outside of Terraform are not detected.",
 									Type:        schema.TypeString,
 									Required:    true,
+									Sensitive:   true,
 								},
 							},
 						},
@@ -274,7 +289,19 @@ func generateResourceTaikunAlertingProfileRead(withRetries bool) schema.ReadCont
 			return diag.FromErr(err)
 		}
 
-		err = setResourceDataFromMap(d, flattenTaikunAlertingProfile(alertingProfileDTO, alertingIntegrationsResponse.Payload))
+		alertingProfileMap := flattenTaikunAlertingProfile(alertingProfileDTO, alertingIntegrationsResponse.Payload)
+		previousWebhooks := d.Get("webhook").(*schema.Set).List()
+		// The alerting profile data sources share this read function
+		if nonExclusive, _ := d.Get("non_exclusive_sub_resources").(bool); nonExclusive {
+			alertingProfileMap["integration"] = filterElementsByPreviousKeys(alertingProfileMap["integration"].([]map[string]interface{}), d.Get("integration"), alertingProfileIntegrationKey)
+			alertingProfileMap["webhook"] = filterElementsByPreviousKeys(alertingProfileMap["webhook"].([]map[string]interface{}), previousWebhooks, alertingProfileWebhookKey)
+		}
+		keepAlertingProfileIntegrationTokens(alertingProfileMap["integration"].([]map[string]interface{}), d.Get("integration"))
+		for _, webhook := range alertingProfileMap["webhook"].([]map[string]interface{}) {
+			keepAlertingProfileWebhookHeaderValues(webhook, previousWebhooks)
+		}
+
+		err = setResourceDataFromMap(d, alertingProfileMap)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -337,13 +364,8 @@ func resourceTaikunAlertingProfileUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	if d.HasChange("webhook") {
-		body := getWebhookDTOsFromAlertingProfileResourceData(d)
-		params := alerting_profiles.NewAlertingProfilesAssignWebhooksParams().WithV(ApiVersion).WithID(id).WithBody(body)
-		_, err := apiClient.Client.AlertingProfiles.AlertingProfilesAssignWebhooks(params, apiClient)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if err := resourceTaikunAlertingProfileUpdateWebhooks(d, id, apiClient); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := resourceTaikunAlertingProfileUpdateIntegrations(d, id, apiClient); err != nil {
@@ -359,6 +381,39 @@ func resourceTaikunAlertingProfileUpdate(ctx context.Context, d *schema.Resource
 	return readAfterUpdateWithRetries(generateResourceTaikunAlertingProfileReadWithRetries(), ctx, d, meta)
 }
 
+// Update the alerting profile's webhooks.
+// Since Taikun replaces all the webhooks at once, the webhooks which aren't
+// declared in the alerting profile are sent back when it isn't exclusive.
+func resourceTaikunAlertingProfileUpdateWebhooks(d *schema.ResourceData, id int32, apiClient *taikungoclient.Client) error {
+	if !d.HasChange("webhook") {
+		return nil
+	}
+
+	body := getWebhookDTOsFromAlertingProfileResourceData(d)
+	if !d.Get("non_exclusive_sub_resources").(bool) {
+		unlock := alertingProfileWebhooksMutex.Lock(id)
+		defer unlock()
+
+		params := alerting_profiles.NewAlertingProfilesAssignWebhooksParams().WithV(ApiVersion).WithID(id).WithBody(body)
+		_, err := apiClient.Client.AlertingProfiles.AlertingProfilesAssignWebhooks(params, apiClient)
+		return err
+	}
+
+	oldWebhooksData, _ := d.GetChange("webhook")
+	oldWebhookURLs := make(map[string]bool)
+	for _, oldWebhookData := range oldWebhooksData.(*schema.Set).List() {
+		oldWebhookURLs[oldWebhookData.(map[string]interface{})["url"].(string)] = true
+	}
+	return updateAlertingProfileWebhooks(id, func(webhooks []*models.AlertingWebhookDto) ([]*models.AlertingWebhookDto, error) {
+		for _, otherWebhook := range webhooks {
+			if !oldWebhookURLs[otherWebhook.URL] {
+				body = append(body, otherWebhook)
+			}
+		}
+		return body, nil
+	}, apiClient)
+}
+
 func resourceTaikunAlertingProfileUpdateIntegrations(d *schema.ResourceData, id int32, apiClient *taikungoclient.Client) (err error) {
 	if !d.HasChange("integration") {
 		return
@@ -415,6 +470,72 @@ func resourceTaikunAlertingProfileDelete(_ context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// getAlertingProfileWebhooks returns the webhooks of an alerting profile
+func getAlertingProfileWebhooks(id int32, apiClient *taikungoclient.Client) ([]*models.AlertingWebhookDto, error) {
+	params := alerting_profiles.NewAlertingProfilesListParams().WithV(ApiVersion).WithID(&id)
+	response, err := apiClient.Client.AlertingProfiles.AlertingProfilesList(params, apiClient)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Payload.Data) != 1 {
+		return nil, fmt.Errorf("alerting profile with ID %d not found", id)
+	}
+	return response.Payload.Data[0].Webhooks, nil
+}
+
+func alertingProfileIntegrationKey(integration map[string]interface{}) string {
+	return fmt.Sprintf("%s/%s", integration["type"], integration["url"])
+}
+
+func alertingProfileWebhookKey(webhook map[string]interface{}) string {
+	return webhook["url"].(string)
+}
+
+// keepAlertingProfileIntegrationTokens replaces the tokens read from the API
+// with the previous tokens of the same integrations, as tokens are write-only
+func keepAlertingProfileIntegrationTokens(integrations []map[string]interface{}, previousData interface{}) {
+	previousTokens := make(map[string]string)
+	previousIntegrations, _ := previousData.([]interface{})
+	for _, rawPreviousIntegration := range previousIntegrations {
+		if previousIntegration, ok := rawPreviousIntegration.(map[string]interface{}); ok {
+			previousTokens[alertingProfileIntegrationKey(previousIntegration)] = previousIntegration["token"].(string)
+		}
+	}
+
+	for _, integration := range integrations {
+		if token, found := previousTokens[alertingProfileIntegrationKey(integration)]; found {
+			integration["token"] = token
+		}
+	}
+}
+
+// keepAlertingProfileWebhookHeaderValues replaces the header values of a
+// webhook read from the API with the previous values of the same headers,
+// as header values are write-only
+func keepAlertingProfileWebhookHeaderValues(webhook map[string]interface{}, previousWebhooks []interface{}) {
+	previousValues := make(map[string]string)
+	for _, rawPreviousWebhook := range previousWebhooks {
+		previousWebhook, ok := rawPreviousWebhook.(map[string]interface{})
+		if !ok || previousWebhook["url"] != webhook["url"] {
+			continue
+		}
+		previousHeaders, _ := previousWebhook["header"].(*schema.Set)
+		if previousHeaders == nil {
+			continue
+		}
+		for _, rawPreviousHeader := range previousHeaders.List() {
+			previousHeader := rawPreviousHeader.(map[string]interface{})
+			previousValues[previousHeader["key"].(string)] = previousHeader["value"].(string)
+		}
+	}
+
+	for _, header := range webhook["header"].([]map[string]interface{}) {
+		if value, found := previousValues[header["key"].(string)]; found {
+			header["value"] = value
+		}
+	}
+}
+
 func getAlertingProfileEmailsResourceFromEmailDTOs(emailDTOs []*models.AlertingEmailDto) []string {
 	emails := make([]string, len(emailDTOs))
 	for i, emailDTO := range emailDTOs {
@@ -502,7 +623,13 @@ func getIntegrationDTOsFromAlertingProfileResourceData(d *schema.ResourceData) [
 }
 
 func flattenTaikunAlertingProfile(alertingProfileDTO *models.AlertingProfilesListDto, alertingIntegrationDto []*models.AlertingIntegrationsListDto) map[string]interface{} {
+	attachedProjectIDs := make([]string, len(alertingProfileDTO.Projects))
+	for i, project := range alertingProfileDTO.Projects {
+		attachedProjectIDs[i] = i32toa(project.ID)
+	}
+
 	return map[string]interface{}{
+		"attached_project_ids":     attachedProjectIDs,
 		"created_by":               alertingProfileDTO.CreatedBy,
 		"emails":                   getAlertingProfileEmailsResourceFromEmailDTOs(alertingProfileDTO.Emails),
 		"id":                       i32toa(alertingProfileDTO.ID),
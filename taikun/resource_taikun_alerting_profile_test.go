@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/itera-io/taikungoclient"
 	"github.com/itera-io/taikungoclient/client/alerting_profiles"
@@ -363,3 +364,67 @@ func testAccCheckTaikunAlertingProfileDestroy(state *terraform.State) error {
 
 	return nil
 }
+
+func TestKeepAlertingProfileIntegrationTokens(t *testing.T) {
+	integrations := []map[string]interface{}{
+		{"id": "1", "token": "", "type": "Opsgenie", "url": "https://opsgenie.example"},
+		{"id": "2", "token": "api-token", "type": "Splunk", "url": "https://splunk.example"},
+	}
+	previousIntegrations := []interface{}{
+		map[string]interface{}{"id": "", "token": "secret", "type": "Opsgenie", "url": "https://opsgenie.example"},
+	}
+
+	keepAlertingProfileIntegrationTokens(integrations, previousIntegrations)
+
+	if integrations[0]["token"] != "secret" {
+		t.Errorf("expected the previous token to be kept, got %v", integrations[0]["token"])
+	}
+	if integrations[1]["token"] != "api-token" {
+		t.Errorf("expected the token read from the API, got %v", integrations[1]["token"])
+	}
+}
+
+func TestKeepAlertingProfileWebhookHeaderValues(t *testing.T) {
+	headerSchema := resourceTaikunAlertingProfileSchema()["webhook"].Elem.(*schema.Resource).Schema["header"]
+	previousHeaders := schema.NewSet(schema.HashResource(headerSchema.Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{"key": "Authorization", "value": "Bearer secret"},
+	})
+	webhook := map[string]interface{}{
+		"header": []map[string]interface{}{
+			{"key": "Authorization", "value": ""},
+			{"key": "X-Team", "value": "ops"},
+		},
+		"url": "https://webhook.example",
+	}
+
+	keepAlertingProfileWebhookHeaderValues(webhook, []interface{}{
+		map[string]interface{}{"header": previousHeaders, "url": "https://other.example"},
+	})
+	if value := webhook["header"].([]map[string]interface{})[0]["value"]; value != "" {
+		t.Fatalf("expected the headers of another webhook to be ignored, got %v", value)
+	}
+
+	keepAlertingProfileWebhookHeaderValues(webhook, []interface{}{
+		map[string]interface{}{"header": previousHeaders, "url": "https://webhook.example"},
+	})
+	headers := webhook["header"].([]map[string]interface{})
+	if headers[0]["value"] != "Bearer secret" || headers[1]["value"] != "ops" {
+		t.Fatalf("unexpected headers %v", headers)
+	}
+}
+
+func TestParseNotificationChannelId(t *testing.T) {
+	alertingProfileId, url, err := parseNotificationChannelId("42/https://webhook.example/path", "taikun_notification_channel_webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alertingProfileId != 42 || url != "https://webhook.example/path" {
+		t.Fatalf("unexpected ID parts %d and %s", alertingProfileId, url)
+	}
+
+	for _, id := range []string{"42", "42/", "foo/1"} {
+		if _, _, err := parseNotificationChannelId(id, "taikun_notification_channel_webhook"); err == nil {
+			t.Errorf("expected %q to be invalid", id)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/itera-io/taikungoclient"
 	"github.com/itera-io/taikungoclient/client/aws"
+	"github.com/itera-io/taikungoclient/client/checker"
 	"github.com/itera-io/taikungoclient/client/cloud_credentials"
 	"github.com/itera-io/taikungoclient/models"
 )
@@ -125,6 +126,7 @@ func resourceTaikunCloudCredentialAWSSchema() map[string]*schema.Schema {
 				false,
 			),
 		},
+		"rotation_trigger": cloudCredentialRotationTriggerSchema(),
 		"secret_access_key": {
 			Description:  "The AWS secret access key.",
 			Type:         schema.TypeString,
@@ -133,6 +135,7 @@ func resourceTaikunCloudCredentialAWSSchema() map[string]*schema.Schema {
 			DefaultFunc:  schema.EnvDefaultFunc("AWS_SECRET_ACCESS_KEY", nil),
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		"validate": cloudCredentialValidateSchema(),
 	}
 }
 
@@ -144,12 +147,19 @@ func resourceTaikunCloudCredentialAWS() *schema.Resource {
 		UpdateContext: resourceTaikunCloudCredentialAWSUpdate,
 		DeleteContext: resourceTaikunCloudCredentialDelete,
 		Schema:        resourceTaikunCloudCredentialAWSSchema(),
+		CustomizeDiff: cloudCredentialCustomizeDiffLocation(cloudTypeAWS),
 	}
 }
 
 func resourceTaikunCloudCredentialAWSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiClient := meta.(*taikungoclient.Client)
 
+	if d.Get("validate").(bool) {
+		if diags := resourceTaikunCloudCredentialAWSCheck(d, apiClient); diags.HasError() {
+			return diags
+		}
+	}
+
 	body := &models.CreateAwsCloudCommand{
 		Name:                d.Get("name").(string),
 		AwsAccessKeyID:      d.Get("access_key_id").(string),
@@ -187,6 +197,25 @@ func resourceTaikunCloudCredentialAWSCreate(ctx context.Context, d *schema.Resou
 
 	return readAfterCreateWithRetries(generateResourceTaikunCloudCredentialAWSReadWithRetries(), ctx, d, meta)
 }
+
+func resourceTaikunCloudCredentialAWSCheck(d *schema.ResourceData, apiClient *taikungoclient.Client) diag.Diagnostics {
+	body := &models.CheckAwsCommand{
+		AwsAccessKeyID:     d.Get("access_key_id").(string),
+		AwsSecretAccessKey: d.Get("secret_access_key").(string),
+		Region:             d.Get("region").(string),
+	}
+
+	params := checker.NewCheckerAwsParams().WithV(ApiVersion).WithBody(body)
+	if _, err := apiClient.Client.Checker.CheckerAws(params, apiClient); err != nil {
+		return cloudCredentialCheckDiagnostics(err, map[string]string{
+			"AwsAccessKeyId":     "access_key_id",
+			"AwsSecretAccessKey": "secret_access_key",
+			"Region":             "region",
+		})
+	}
+
+	return nil
+}
 func generateResourceTaikunCloudCredentialAWSReadWithRetries() schema.ReadContextFunc {
 	return generateResourceTaikunCloudCredentialAWSRead(true)
 }
@@ -240,7 +269,13 @@ func resourceTaikunCloudCredentialAWSUpdate(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	if d.HasChanges("access_key_id", "secret_access_key", "name") {
+	if d.HasChanges("access_key_id", "secret_access_key", "name", "rotation_trigger") {
+		if d.Get("validate").(bool) && d.HasChanges("access_key_id", "secret_access_key", "rotation_trigger") {
+			if diags := resourceTaikunCloudCredentialAWSCheck(d, apiClient); diags.HasError() {
+				return diags
+			}
+		}
+
 		updateBody := &models.UpdateAwsCommand{
 			ID:                 id,
 			Name:               d.Get("name").(string),
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/itera-io/taikungoclient"
 	"github.com/itera-io/taikungoclient/client/azure"
+	"github.com/itera-io/taikungoclient/client/checker"
 	"github.com/itera-io/taikungoclient/client/cloud_credentials"
 	"github.com/itera-io/taikungoclient/models"
 )
@@ -101,6 +102,7 @@ func resourceTaikunCloudCredentialAzureSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"rotation_trigger": cloudCredentialRotationTriggerSchema(),
 		"subscription_id": {
 			Description:  "The Azure subscription ID.",
 			Type:         schema.TypeString,
@@ -117,6 +119,7 @@ func resourceTaikunCloudCredentialAzureSchema() map[string]*schema.Schema {
 			DefaultFunc:  schema.EnvDefaultFunc("ARM_TENANT_ID", nil),
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		"validate": cloudCredentialValidateSchema(),
 	}
 }
 
@@ -128,12 +131,19 @@ func resourceTaikunCloudCredentialAzure() *schema.Resource {
 		UpdateContext: resourceTaikunCloudCredentialAzureUpdate,
 		DeleteContext: resourceTaikunCloudCredentialDelete,
 		Schema:        resourceTaikunCloudCredentialAzureSchema(),
+		CustomizeDiff: cloudCredentialCustomizeDiffLocation(cloudTypeAzure),
 	}
 }
 
 func resourceTaikunCloudCredentialAzureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiClient := meta.(*taikungoclient.Client)
 
+	if d.Get("validate").(bool) {
+		if diags := resourceTaikunCloudCredentialAzureCheck(d, apiClient); diags.HasError() {
+			return diags
+		}
+	}
+
 	body := &models.CreateAzureCloudCommand{
 		Name:                  d.Get("name").(string),
 		AzureTenantID:         d.Get("tenant_id").(string),
@@ -173,6 +183,25 @@ func resourceTaikunCloudCredentialAzureCreate(ctx context.Context, d *schema.Res
 
 	return readAfterCreateWithRetries(generateResourceTaikunCloudCredentialAzureReadWithRetries(), ctx, d, meta)
 }
+
+func resourceTaikunCloudCredentialAzureCheck(d *schema.ResourceData, apiClient *taikungoclient.Client) diag.Diagnostics {
+	body := &models.CheckAzureCommand{
+		AzureClientID:     d.Get("client_id").(string),
+		AzureClientSecret: d.Get("client_secret").(string),
+		AzureTenantID:     d.Get("tenant_id").(string),
+	}
+
+	params := checker.NewCheckerAzureParams().WithV(ApiVersion).WithBody(body)
+	if _, err := apiClient.Client.Checker.CheckerAzure(params, apiClient); err != nil {
+		return cloudCredentialCheckDiagnostics(err, map[string]string{
+			"AzureClientId":     "client_id",
+			"AzureClientSecret": "client_secret",
+			"AzureTenantId":     "tenant_id",
+		})
+	}
+
+	return nil
+}
 func generateResourceTaikunCloudCredentialAzureReadWithRetries() schema.ReadContextFunc {
 	return generateResourceTaikunCloudCredentialAzureRead(true)
 }
@@ -226,7 +255,13 @@ func resourceTaikunCloudCredentialAzureUpdate(ctx context.Context, d *schema.Res
 		}
 	}
 
-	if d.HasChanges("client_id", "client_secret", "name") {
+	if d.HasChanges("client_id", "client_secret", "name", "rotation_trigger") {
+		if d.Get("validate").(bool) && d.HasChanges("client_id", "client_secret", "rotation_trigger") {
+			if diags := resourceTaikunCloudCredentialAzureCheck(d, apiClient); diags.HasError() {
+				return diags
+			}
+		}
+
 		updateBody := &models.UpdateAzureCommand{
 			ID:                id,
 			Name:              d.Get("name").(string),
@@ -2,11 +2,21 @@ package taikun
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/aws"
+	"github.com/itera-io/taikungoclient/client/azure"
 	"github.com/itera-io/taikungoclient/client/cloud_credentials"
+	"github.com/itera-io/taikungoclient/client/google_cloud"
+	"github.com/itera-io/taikungoclient/client/openstack"
+	"github.com/itera-io/taikungoclient/models"
 )
 
 func resourceTaikunCloudCredentialDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -25,3 +35,284 @@ func resourceTaikunCloudCredentialDelete(_ context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return nil
 }
+
+func cloudCredentialValidateSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Whether to check the credentials with the cloud provider before creating the cloud credential.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func cloudCredentialRotationTriggerSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Arbitrary value, e.g. a timestamp or a secret version, changing it sends the cloud credential's secret to Taikun again without replacing the cloud credential.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+}
+
+type cloudCredentialCheckFailure interface {
+	GetPayload() *models.ValidationProblemDetails
+}
+
+// cloudCredentialCheckDiagnostics converts an error returned by one of the
+// checker endpoints into diagnostics, the errors of each field of the check
+// command are attached to their attribute in fieldAttributes
+func cloudCredentialCheckDiagnostics(err error, fieldAttributes map[string]string) diag.Diagnostics {
+	failure, ok := err.(cloudCredentialCheckFailure)
+	if !ok || failure.GetPayload() == nil {
+		return diag.Errorf("failed to check cloud credentials: %s", err)
+	}
+	payload := failure.GetPayload()
+
+	var diags diag.Diagnostics
+	fields := make([]string, 0, len(payload.Errors))
+	for field := range payload.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		var attributePath cty.Path
+		for apiField, attribute := range fieldAttributes {
+			if strings.EqualFold(field, apiField) {
+				attributePath = cty.GetAttrPath(attribute)
+				break
+			}
+		}
+		for _, message := range payload.Errors[field] {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "invalid cloud credentials",
+				Detail:        message,
+				AttributePath: attributePath,
+			})
+		}
+	}
+
+	if len(diags) == 0 {
+		detail := payload.Detail
+		if detail == "" {
+			detail = payload.Title
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "invalid cloud credentials",
+			Detail:   detail,
+		})
+	}
+
+	return diags
+}
+
+// cloudCredentialGetter returns the value of a cloud credential attribute,
+// e.g. schema.ResourceData.Get or schema.ResourceDiff.Get
+type cloudCredentialGetter func(key string) interface{}
+
+// Attributes of each cloud type needed to list its regions and availability zones
+var cloudCredentialLocationKeys = map[string][]string{
+	cloudTypeAWS:       {"access_key_id", "secret_access_key"},
+	cloudTypeAzure:     {"client_id", "client_secret", "subscription_id", "tenant_id"},
+	cloudTypeGCP:       {"config_file", "config_json"},
+	cloudTypeOpenStack: {"application_credential_id", "application_credential_secret", "domain", "password", "url", "user"},
+}
+
+type cloudCredentialLocationAttributes struct {
+	region string
+	zone   string
+}
+
+// Region and availability zone attributes of each cloud type
+var cloudCredentialLocations = map[string]cloudCredentialLocationAttributes{
+	cloudTypeAWS:       {region: "region", zone: "availability_zone"},
+	cloudTypeAzure:     {region: "location", zone: "availability_zone"},
+	cloudTypeGCP:       {region: "region", zone: "zone"},
+	cloudTypeOpenStack: {region: "region", zone: "availability_zone"},
+}
+
+// cloudCredentialListRegions returns the regions, or locations for Azure,
+// available with the given credentials
+func cloudCredentialListRegions(cloudType string, get cloudCredentialGetter, apiClient *taikungoclient.Client) ([]string, error) {
+	switch cloudType {
+	case cloudTypeAWS:
+		body := &models.RegionListCommand{
+			AwsAccessKeyID:     get("access_key_id").(string),
+			AwsSecretAccessKey: get("secret_access_key").(string),
+		}
+		response, err := apiClient.Client.Aws.AwsRegionList(aws.NewAwsRegionListParams().WithV(ApiVersion).WithBody(body), apiClient)
+		if err != nil {
+			return nil, err
+		}
+		regions := make([]string, len(response.Payload))
+		for i, region := range response.Payload {
+			regions[i] = region.Region
+		}
+		return regions, nil
+	case cloudTypeAzure:
+		body := &models.AzureLocationsCommand{
+			AzureClientID:       get("client_id").(string),
+			AzureClientSecret:   get("client_secret").(string),
+			AzureSubscriptionID: get("subscription_id").(string),
+			AzureTenantID:       get("tenant_id").(string),
+		}
+		response, err := apiClient.Client.Azure.AzureLocations(azure.NewAzureLocationsParams().WithV(ApiVersion).WithBody(body), apiClient)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	case cloudTypeGCP:
+		config, err := resourceTaikunCloudCredentialGCPGetConfig(get)
+		if err != nil {
+			return nil, err
+		}
+		defer config.Close()
+		response, err := apiClient.Client.GoogleCloud.GoogleCloudRegionList(google_cloud.NewGoogleCloudRegionListParams().WithV(ApiVersion).WithConfig(config), apiClient)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	default: // OpenStack
+		user, password, applicationCredentialEnabled := resourceTaikunCloudCredentialOpenStackGetAuth(get)
+		body := &models.OpenStackRegionListQuery{
+			ApplicationCredEnabled: applicationCredentialEnabled,
+			OpenStackDomain:        get("domain").(string),
+			OpenStackPassword:      password,
+			OpenStackURL:           get("url").(string),
+			OpenStackUser:          user,
+		}
+		response, err := apiClient.Client.Openstack.OpenstackRegions(openstack.NewOpenstackRegionsParams().WithV(ApiVersion).WithBody(body), apiClient)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	}
+}
+
+// cloudCredentialListAvailabilityZones returns the availability zones of the
+// region, or location for Azure, available with the given credentials
+func cloudCredentialListAvailabilityZones(cloudType string, get cloudCredentialGetter, region string, apiClient *taikungoclient.Client) ([]string, error) {
+	switch cloudType {
+	case cloudTypeAWS:
+		body := &models.AmazonAvailabilityZonesCommand{
+			AwsAccessKeyID:     get("access_key_id").(string),
+			AwsSecretAccessKey: get("secret_access_key").(string),
+			Region:             region,
+		}
+		response, err := apiClient.Client.Aws.AwsAwsZoneList(aws.NewAwsAwsZoneListParams().WithV(ApiVersion).WithBody(body), apiClient)
+		if err != nil {
+			return nil, err
+		}
+		// AWS cloud credentials expect the zone's suffix, e.g. a for eu-central-1a
+		zones := make([]string, len(response.Payload))
+		for i, zone := range response.Payload {
+			zones[i] = strings.TrimPrefix(zone, region)
+		}
+		return zones, nil
+	case cloudTypeAzure:
+		body := &models.AzureZonesCommand{
+			AzureClientID:       get("client_id").(string),
+			AzureClientSecret:   get("client_secret").(string),
+			AzureLocation:       region,
+			AzureSubscriptionID: get("subscription_id").(string),
+			AzureTenantID:       get("tenant_id").(string),
+		}
+		response, err := apiClient.Client.Azure.AzureZones(azure.NewAzureZonesParams().WithV(ApiVersion).WithBody(body), apiClient)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	case cloudTypeGCP:
+		config, err := resourceTaikunCloudCredentialGCPGetConfig(get)
+		if err != nil {
+			return nil, err
+		}
+		defer config.Close()
+		params := google_cloud.NewGoogleCloudZoneListParams().WithV(ApiVersion).WithConfig(config).WithRegion(&region)
+		response, err := apiClient.Client.GoogleCloud.GoogleCloudZoneList(params, apiClient)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	default: // OpenStack
+		user, password, applicationCredentialEnabled := resourceTaikunCloudCredentialOpenStackGetAuth(get)
+		body := &models.OpenStackZoneListQuery{
+			ApplicationCredEnabled: applicationCredentialEnabled,
+			OpenStackDomain:        get("domain").(string),
+			OpenStackPassword:      password,
+			OpenStackRegion:        region,
+			OpenStackURL:           get("url").(string),
+			OpenStackUser:          user,
+		}
+		response, err := apiClient.Client.Openstack.OpenstackZoneList(openstack.NewOpenstackZoneListParams().WithV(ApiVersion).WithBody(body), apiClient)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	}
+}
+
+// cloudCredentialCheckLocation returns an error if the value of the region or
+// availability zone attribute is not among the available ones
+func cloudCredentialCheckLocation(attribute string, value string, available []string) error {
+	if value == "" || len(available) == 0 {
+		return nil
+	}
+	for _, location := range available {
+		if location == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s %q is not available with these credentials, expected one of: %s", attribute, value, strings.Join(available, ", "))
+}
+
+// cloudCredentialCustomizeDiffLocation checks at plan time that the region and
+// availability zone of a new cloud credential exist
+func cloudCredentialCustomizeDiffLocation(cloudType string) schema.CustomizeDiffFunc {
+	regionAttribute := cloudCredentialLocations[cloudType].region
+	zoneAttribute := cloudCredentialLocations[cloudType].zone
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" && !d.HasChanges(regionAttribute, zoneAttribute) {
+			return nil
+		}
+		for _, key := range append([]string{regionAttribute, zoneAttribute}, cloudCredentialLocationKeys[cloudType]...) {
+			if !d.NewValueKnown(key) {
+				return nil
+			}
+		}
+
+		region := d.Get(regionAttribute).(string)
+		if region == "" {
+			return nil
+		}
+
+		// Invalid credentials are reported when the cloud credential is created
+		apiClient := meta.(*taikungoclient.Client)
+		regions, err := cloudCredentialListRegions(cloudType, d.Get, apiClient)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping the %s check, the regions could not be listed", regionAttribute), map[string]interface{}{
+				regionAttribute: region,
+				"error":         err.Error(),
+			})
+			return nil
+		}
+		if err := cloudCredentialCheckLocation(regionAttribute, region, regions); err != nil {
+			return err
+		}
+
+		zone := d.Get(zoneAttribute).(string)
+		if zone == "" {
+			return nil
+		}
+		zones, err := cloudCredentialListAvailabilityZones(cloudType, d.Get, region, apiClient)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping the %s check, the availability zones could not be listed", zoneAttribute), map[string]interface{}{
+				zoneAttribute: zone,
+				"error":       err.Error(),
+			})
+			return nil
+		}
+		return cloudCredentialCheckLocation(zoneAttribute, zone, zones)
+	}
+}
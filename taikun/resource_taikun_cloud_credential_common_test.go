@@ -0,0 +1,185 @@
+package taikun
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client"
+)
+
+// newStubTaikunClient returns a client sending its requests to a stub Taikun
+// API, checkerHandler handles the requests to the checker endpoints
+func newStubTaikunClient(t *testing.T, checkerHandler http.HandlerFunc) *taikungoclient.Client {
+	claims, _ := json.Marshal(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix()})
+	token := fmt.Sprintf("header.%s.signature", base64.RawURLEncoding.EncodeToString(claims))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/Auth/login", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"token": %q, "refreshToken": "refresh"}`, token)
+	})
+	mux.HandleFunc("/api/v1/Checker/", checkerHandler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	apiClient, err := taikungoclient.NewClientFromCredentials("test@example.com", "password", false, host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transportConfig := client.DefaultTransportConfig().WithHost(host).WithSchemes([]string{"http"})
+	apiClient.Client = client.NewHTTPClientWithConfig(nil, transportConfig)
+
+	return apiClient
+}
+
+func stubCheckerValidationErrors(errors map[string][]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"title":  "One or more validation errors occurred.",
+			"status": http.StatusBadRequest,
+			"errors": errors,
+		})
+	}
+}
+
+func TestResourceTaikunCloudCredentialAWSCheckValid(t *testing.T) {
+	var checkedPath string
+	apiClient := newStubTaikunClient(t, func(w http.ResponseWriter, r *http.Request) {
+		checkedPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceTaikunCloudCredentialAWSSchema(), map[string]interface{}{
+		"access_key_id":     "AKIAEXAMPLE",
+		"secret_access_key": "secret",
+		"region":            "eu-central-1",
+	})
+
+	if diags := resourceTaikunCloudCredentialAWSCheck(d, apiClient); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if checkedPath != "/api/v1/Checker/aws" {
+		t.Fatalf("expected AWS checker to be called, got %q", checkedPath)
+	}
+}
+
+func TestResourceTaikunCloudCredentialAWSCheckInvalid(t *testing.T) {
+	apiClient := newStubTaikunClient(t, stubCheckerValidationErrors(map[string][]string{
+		"awsSecretAccessKey": {"The secret access key is invalid."},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceTaikunCloudCredentialAWSSchema(), map[string]interface{}{
+		"access_key_id":     "AKIAEXAMPLE",
+		"secret_access_key": "wrong",
+		"region":            "eu-central-1",
+	})
+
+	diags := resourceTaikunCloudCredentialAWSCheck(d, apiClient)
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d: %v", len(diags), diags)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("secret_access_key")) {
+		t.Fatalf("expected diagnostic on secret_access_key, got %#v", diags[0].AttributePath)
+	}
+	if diags[0].Detail != "The secret access key is invalid." {
+		t.Fatalf("unexpected detail %q", diags[0].Detail)
+	}
+}
+
+func TestResourceTaikunCloudCredentialAzureCheckInvalid(t *testing.T) {
+	apiClient := newStubTaikunClient(t, stubCheckerValidationErrors(map[string][]string{
+		"AzureClientId": {"Unknown client."},
+		"AzureTenantId": {"Unknown tenant."},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceTaikunCloudCredentialAzureSchema(), map[string]interface{}{
+		"client_id":     "client",
+		"client_secret": "secret",
+		"tenant_id":     "tenant",
+	})
+
+	diags := resourceTaikunCloudCredentialAzureCheck(d, apiClient)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+	for i, attribute := range []string{"client_id", "tenant_id"} {
+		if !diags[i].AttributePath.Equals(cty.GetAttrPath(attribute)) {
+			t.Fatalf("expected diagnostic %d on %s, got %#v", i, attribute, diags[i].AttributePath)
+		}
+	}
+}
+
+func TestResourceTaikunCloudCredentialOpenStackCheckInvalid(t *testing.T) {
+	apiClient := newStubTaikunClient(t, stubCheckerValidationErrors(map[string][]string{
+		"": {"Authentication failed."},
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceTaikunCloudCredentialOpenStackSchema(), map[string]interface{}{
+		"domain":   "Default",
+		"password": "wrong",
+		"url":      "https://keystone.example.com:5000/v3",
+		"user":     "admin",
+	})
+
+	diags := resourceTaikunCloudCredentialOpenStackCheck(d, apiClient)
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d: %v", len(diags), diags)
+	}
+	if diags[0].AttributePath != nil {
+		t.Fatalf("expected diagnostic without attribute, got %#v", diags[0].AttributePath)
+	}
+	if diags[0].Detail != "Authentication failed." {
+		t.Fatalf("unexpected detail %q", diags[0].Detail)
+	}
+}
+
+func TestResourceTaikunCloudCredentialSecretsRotateInPlace(t *testing.T) {
+	rotatableAttributes := map[string]map[string]*schema.Schema{
+		"secret_access_key": resourceTaikunCloudCredentialAWSSchema(),
+		"client_secret":     resourceTaikunCloudCredentialAzureSchema(),
+		"password":          resourceTaikunCloudCredentialOpenStackSchema(),
+	}
+
+	for attribute, resourceSchema := range rotatableAttributes {
+		for _, key := range []string{attribute, "rotation_trigger"} {
+			if resourceSchema[key].ForceNew {
+				t.Errorf("%s must be updated in place (rotating %s)", key, attribute)
+			}
+		}
+	}
+}
+
+func TestCloudCredentialCheckLocation(t *testing.T) {
+	available := []string{"eu-central-1", "eu-west-1"}
+
+	if err := cloudCredentialCheckLocation("region", "eu-west-1", available); err != nil {
+		t.Fatalf("expected available region to be accepted, got %v", err)
+	}
+	if err := cloudCredentialCheckLocation("region", "", available); err != nil {
+		t.Fatalf("expected empty region to be accepted, got %v", err)
+	}
+	if err := cloudCredentialCheckLocation("region", "us-east-1", nil); err != nil {
+		t.Fatalf("expected region to be accepted when no locations are listed, got %v", err)
+	}
+
+	err := cloudCredentialCheckLocation("region", "us-east-1", available)
+	if err == nil {
+		t.Fatal("expected unavailable region to be rejected")
+	}
+	if !strings.Contains(err.Error(), "eu-central-1, eu-west-1") {
+		t.Fatalf("expected error to list the available regions, got %q", err)
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"os"
 	"regexp"
+	"strings"
 
+	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/checker"
 	"github.com/itera-io/taikungoclient/client/cloud_credentials"
 	"github.com/itera-io/taikungoclient/client/google_cloud"
 	"github.com/itera-io/taikungoclient/models"
@@ -32,9 +35,19 @@ func resourceTaikunCloudCredentialGCPSchema() map[string]*schema.Schema {
 		"config_file": {
 			Description:      "The path of the GCP credential's configuration file.",
 			Type:             schema.TypeString,
-			Required:         true,
+			Optional:         true,
 			ForceNew:         true,
 			ValidateDiagFunc: stringIsFilePath,
+			ExactlyOneOf:     []string{"config_file", "config_json"},
+		},
+		"config_json": {
+			Description:      "The content of the GCP credential's configuration file, i.e. the service account key in JSON format.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			ForceNew:         true,
+			ValidateDiagFunc: stringIsGCPServiceAccountKey,
+			ExactlyOneOf:     []string{"config_file", "config_json"},
 		},
 		"folder_id": {
 			Description:   "The folder ID of the GCP credential.",
@@ -99,6 +112,7 @@ func resourceTaikunCloudCredentialGCPSchema() map[string]*schema.Schema {
 			ForceNew:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		"validate": cloudCredentialValidateSchema(),
 		"zone": {
 			Description:  "The zone of the GCP credential.",
 			Type:         schema.TypeString,
@@ -117,19 +131,27 @@ func resourceTaikunCloudCredentialGCP() *schema.Resource {
 		UpdateContext: resourceTaikunCloudCredentialGCPUpdate,
 		DeleteContext: resourceTaikunCloudCredentialDelete,
 		Schema:        resourceTaikunCloudCredentialGCPSchema(),
+		CustomizeDiff: cloudCredentialCustomizeDiffLocation(cloudTypeGCP),
 	}
 }
 
 func resourceTaikunCloudCredentialGCPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiClient := meta.(*taikungoclient.Client)
 
+	if d.Get("validate").(bool) {
+		if diags := resourceTaikunCloudCredentialGCPCheck(d, apiClient); diags.HasError() {
+			return diags
+		}
+	}
+
 	params := google_cloud.NewGoogleCloudCreateParams().WithV(ApiVersion)
 
-	configFile, err := os.Open(d.Get("config_file").(string))
+	config, err := resourceTaikunCloudCredentialGCPGetConfig(d.Get)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	params = params.WithConfig(configFile)
+	defer config.Close()
+	params = params.WithConfig(config)
 
 	name := d.Get("name").(string)
 	params = params.WithName(&name)
@@ -174,6 +196,37 @@ func resourceTaikunCloudCredentialGCPCreate(ctx context.Context, d *schema.Resou
 	return readAfterCreateWithRetries(generateResourceTaikunCloudCredentialGCPReadWithRetries(), ctx, d, meta)
 }
 
+func resourceTaikunCloudCredentialGCPCheck(d *schema.ResourceData, apiClient *taikungoclient.Client) diag.Diagnostics {
+	config, err := resourceTaikunCloudCredentialGCPGetConfig(d.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer config.Close()
+
+	configAttribute := "config_file"
+	if _, configJSONIsSet := d.GetOk("config_json"); configJSONIsSet {
+		configAttribute = "config_json"
+	}
+
+	params := checker.NewCheckerGoogleParams().WithV(ApiVersion).WithConfig(config)
+	if _, err := apiClient.Client.Checker.CheckerGoogle(params, apiClient); err != nil {
+		return cloudCredentialCheckDiagnostics(err, map[string]string{
+			"Config": configAttribute,
+		})
+	}
+
+	return nil
+}
+
+// resourceTaikunCloudCredentialGCPGetConfig returns the configuration file's
+// content, read from config_json if it is set and from config_file otherwise
+func resourceTaikunCloudCredentialGCPGetConfig(get cloudCredentialGetter) (runtime.NamedReadCloser, error) {
+	if configJSON := get("config_json").(string); configJSON != "" {
+		return runtime.NamedReader("config.json", strings.NewReader(configJSON)), nil
+	}
+	return os.Open(get("config_file").(string))
+}
+
 func generateResourceTaikunCloudCredentialGCPReadWithRetries() schema.ReadContextFunc {
 	return generateResourceTaikunCloudCredentialGCPRead(true)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -95,6 +96,66 @@ func TestAccResourceTaikunCloudCredentialGCPImportProject(t *testing.T) {
 	})
 }
 
+const testAccResourceTaikunCloudCredentialGCPConfigJSONConfig = `
+resource "taikun_cloud_credential_gcp" "foo" {
+  name = "%s"
+  config_json = file("./gcp.json")
+  import_project = true
+  region = "%s"
+  zone = "%s"
+}
+`
+
+func TestAccResourceTaikunCloudCredentialGCPConfigJSON(t *testing.T) {
+	cloudCredentialName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckGCP(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunCloudCredentialGCPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunCloudCredentialGCPConfigJSONConfig,
+					cloudCredentialName,
+					os.Getenv("GCP_REGION"),
+					os.Getenv("GCP_ZONE"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunCloudCredentialGCPExists,
+					resource.TestCheckNoResourceAttr("taikun_cloud_credential_gcp.foo", "config_file"),
+					resource.TestCheckResourceAttrSet("taikun_cloud_credential_gcp.foo", "config_json"),
+					resource.TestCheckResourceAttr("taikun_cloud_credential_gcp.foo", "name", cloudCredentialName),
+					resource.TestCheckResourceAttr("taikun_cloud_credential_gcp.foo", "region", os.Getenv("GCP_REGION")),
+					resource.TestCheckResourceAttr("taikun_cloud_credential_gcp.foo", "zone", os.Getenv("GCP_ZONE")),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceTaikunCloudCredentialGCPInvalidConfigJSONConfig = `
+resource "taikun_cloud_credential_gcp" "foo" {
+  name = "%s"
+  config_json = jsonencode({ type = "authorized_user", project_id = "foo" })
+  import_project = true
+  region = "europe-west1"
+  zone = "europe-west1-b"
+}
+`
+
+func TestAccResourceTaikunCloudCredentialGCPInvalidConfigJSON(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccResourceTaikunCloudCredentialGCPInvalidConfigJSONConfig, randomTestName()),
+				ExpectError: regexp.MustCompile(`expected a service account key`),
+			},
+		},
+	})
+}
+
 func testAccCheckTaikunCloudCredentialGCPExists(state *terraform.State) error {
 	client := testAccProvider.Meta().(*taikungoclient.Client)
 
@@ -2,12 +2,16 @@ package taikun
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/checker"
 	"github.com/itera-io/taikungoclient/client/cloud_credentials"
 	"github.com/itera-io/taikungoclient/client/openstack"
 	"github.com/itera-io/taikungoclient/models"
@@ -15,6 +19,21 @@ import (
 
 func resourceTaikunCloudCredentialOpenStackSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
+		"application_credential_id": {
+			Description:  "The ID of the OpenStack application credential, an alternative to `user` and `password`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"application_credential_secret"},
+		},
+		"application_credential_secret": {
+			Description:  "The secret of the OpenStack application credential.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"application_credential_id"},
+		},
 		"availability_zone": {
 			Description: "The OpenStack availability zone.",
 			Type:        schema.TypeString,
@@ -92,9 +111,9 @@ func resourceTaikunCloudCredentialOpenStackSchema() map[string]*schema.Schema {
 			Computed:    true,
 		},
 		"password": {
-			Description:  "The OpenStack password.",
+			Description:  "The OpenStack password. Required unless `application_credential_id` is set.",
 			Type:         schema.TypeString,
-			Required:     true,
+			Optional:     true,
 			Sensitive:    true,
 			DefaultFunc:  schema.EnvDefaultFunc("OS_PASSWORD", nil),
 			ValidateFunc: validation.StringIsNotEmpty,
@@ -128,6 +147,7 @@ func resourceTaikunCloudCredentialOpenStackSchema() map[string]*schema.Schema {
 			DefaultFunc:  schema.EnvDefaultFunc("OS_REGION_NAME", nil),
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		"rotation_trigger": cloudCredentialRotationTriggerSchema(),
 		"url": {
 			Description:  "The OpenStack authentication URL.",
 			Type:         schema.TypeString,
@@ -137,12 +157,13 @@ func resourceTaikunCloudCredentialOpenStackSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 		"user": {
-			Description:  "The OpenStack user.",
+			Description:  "The OpenStack user. Required unless `application_credential_id` is set.",
 			Type:         schema.TypeString,
-			Required:     true,
+			Optional:     true,
 			DefaultFunc:  schema.EnvDefaultFunc("OS_USERNAME", nil),
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+		"validate": cloudCredentialValidateSchema(),
 		"volume_type_name": {
 			Description: "The OpenStack type of volume.",
 			Type:        schema.TypeString,
@@ -160,16 +181,91 @@ func resourceTaikunCloudCredentialOpenStack() *schema.Resource {
 		UpdateContext: resourceTaikunCloudCredentialOpenStackUpdate,
 		DeleteContext: resourceTaikunCloudCredentialDelete,
 		Schema:        resourceTaikunCloudCredentialOpenStackSchema(),
+		CustomizeDiff: customdiff.All(
+			resourceTaikunCloudCredentialOpenStackCustomizeDiff,
+			cloudCredentialCustomizeDiffLocation(cloudTypeOpenStack),
+		),
+	}
+}
+
+// resourceTaikunCloudCredentialOpenStackSwitchesAuth returns whether changing
+// the application credential ID switches between the two authentication
+// methods. The API returns no authentication method and returns the
+// application credential ID as the user, so an imported cloud credential
+// using application credentials holds its application credential ID as its
+// user until the configuration's application_credential_id is applied.
+func resourceTaikunCloudCredentialOpenStackSwitchesAuth(oldID string, newID string, oldUser string) bool {
+	if oldID == "" && newID != "" && oldUser == newID {
+		return false
+	}
+	return (oldID == "") != (newID == "")
+}
+
+func resourceTaikunCloudCredentialOpenStackCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if err := resourceTaikunCloudCredentialOpenStackValidateAuth(d.GetRawConfig(), d.Get); err != nil {
+		return err
+	}
+
+	// The authentication method of a cloud credential cannot be updated
+	if d.Id() != "" && d.HasChange("application_credential_id") {
+		oldID, newID := d.GetChange("application_credential_id")
+		oldUser, _ := d.GetChange("user")
+		if resourceTaikunCloudCredentialOpenStackSwitchesAuth(oldID.(string), newID.(string), oldUser.(string)) {
+			return d.ForceNew("application_credential_id")
+		}
+	}
+
+	return nil
+}
+
+// resourceTaikunCloudCredentialOpenStackValidateAuth checks that exactly one
+// authentication method is set. The conflicts are checked against the
+// configuration, as user and password may default to environment variables.
+func resourceTaikunCloudCredentialOpenStackValidateAuth(rawConfig cty.Value, get cloudCredentialGetter) error {
+	if rawConfig.IsKnown() && !rawConfig.IsNull() {
+		for _, applicationCredentialKey := range []string{"application_credential_id", "application_credential_secret"} {
+			if rawConfig.GetAttr(applicationCredentialKey).IsNull() {
+				continue
+			}
+			for _, userKey := range []string{"user", "password"} {
+				if !rawConfig.GetAttr(userKey).IsNull() {
+					return fmt.Errorf("%s conflicts with %s, set either user and password or application_credential_id and application_credential_secret", applicationCredentialKey, userKey)
+				}
+			}
+		}
+	}
+
+	if get("application_credential_id").(string) == "" && (get("user").(string) == "" || get("password").(string) == "") {
+		return fmt.Errorf("either user and password or application_credential_id and application_credential_secret must be set")
+	}
+
+	return nil
+}
+
+// resourceTaikunCloudCredentialOpenStackGetAuth returns the user and password
+// sent to Taikun, i.e. the application credential if it is set
+func resourceTaikunCloudCredentialOpenStackGetAuth(get cloudCredentialGetter) (user string, password string, applicationCredentialEnabled bool) {
+	if applicationCredentialID := get("application_credential_id").(string); applicationCredentialID != "" {
+		return applicationCredentialID, get("application_credential_secret").(string), true
 	}
+	return get("user").(string), get("password").(string), false
 }
 
 func resourceTaikunCloudCredentialOpenStackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiClient := meta.(*taikungoclient.Client)
 
+	if d.Get("validate").(bool) {
+		if diags := resourceTaikunCloudCredentialOpenStackCheck(d, apiClient); diags.HasError() {
+			return diags
+		}
+	}
+
+	user, password, applicationCredentialEnabled := resourceTaikunCloudCredentialOpenStackGetAuth(d.Get)
 	body := &models.CreateOpenstackCloudCommand{
+		ApplicationCredEnabled: applicationCredentialEnabled,
 		Name:                   d.Get("name").(string),
-		OpenStackUser:          d.Get("user").(string),
-		OpenStackPassword:      d.Get("password").(string),
+		OpenStackUser:          user,
+		OpenStackPassword:      password,
 		OpenStackURL:           d.Get("url").(string),
 		OpenStackProject:       d.Get("project_name").(string),
 		OpenStackPublicNetwork: d.Get("public_network_name").(string),
@@ -222,6 +318,36 @@ func resourceTaikunCloudCredentialOpenStackCreate(ctx context.Context, d *schema
 
 	return readAfterCreateWithRetries(generateResourceTaikunCloudCredentialOpenStackReadWithRetries(), ctx, d, meta)
 }
+
+func resourceTaikunCloudCredentialOpenStackCheck(d *schema.ResourceData, apiClient *taikungoclient.Client) diag.Diagnostics {
+	if _, _, applicationCredentialEnabled := resourceTaikunCloudCredentialOpenStackGetAuth(d.Get); applicationCredentialEnabled {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "cloud credentials not checked",
+			Detail:        "Taikun cannot check OpenStack application credentials, they will be used as is.",
+			AttributePath: cty.GetAttrPath("application_credential_id"),
+		}}
+	}
+
+	body := &models.CheckOpenstackCommand{
+		OpenStackDomain:   d.Get("domain").(string),
+		OpenStackPassword: d.Get("password").(string),
+		OpenStackURL:      d.Get("url").(string),
+		OpenStackUser:     d.Get("user").(string),
+	}
+
+	params := checker.NewCheckerOpenstackParams().WithV(ApiVersion).WithBody(body)
+	if _, err := apiClient.Client.Checker.CheckerOpenstack(params, apiClient); err != nil {
+		return cloudCredentialCheckDiagnostics(err, map[string]string{
+			"OpenStackDomain":   "domain",
+			"OpenStackPassword": "password",
+			"OpenStackUrl":      "url",
+			"OpenStackUser":     "user",
+		})
+	}
+
+	return nil
+}
 func generateResourceTaikunCloudCredentialOpenStackReadWithRetries() schema.ReadContextFunc {
 	return generateResourceTaikunCloudCredentialOpenStackRead(true)
 }
@@ -251,7 +377,15 @@ func generateResourceTaikunCloudCredentialOpenStackRead(withRetries bool) schema
 
 		rawCloudCredentialOpenStack := response.GetPayload().Openstack[0]
 
-		err = setResourceDataFromMap(d, flattenTaikunCloudCredentialOpenStack(rawCloudCredentialOpenStack))
+		openStackMap := flattenTaikunCloudCredentialOpenStack(rawCloudCredentialOpenStack)
+
+		// With application credentials, the API returns the application credential ID as the user
+		if _, applicationCredentialIsSet := d.GetOk("application_credential_id"); applicationCredentialIsSet {
+			openStackMap["application_credential_id"] = openStackMap["user"]
+			delete(openStackMap, "user")
+		}
+
+		err = setResourceDataFromMap(d, openStackMap)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -275,12 +409,19 @@ func resourceTaikunCloudCredentialOpenStackUpdate(ctx context.Context, d *schema
 		}
 	}
 
-	if d.HasChanges("user", "password", "name") {
+	if d.HasChanges("application_credential_id", "application_credential_secret", "user", "password", "name", "rotation_trigger") {
+		if d.Get("validate").(bool) && d.HasChanges("application_credential_id", "application_credential_secret", "user", "password", "rotation_trigger") {
+			if diags := resourceTaikunCloudCredentialOpenStackCheck(d, apiClient); diags.HasError() {
+				return diags
+			}
+		}
+
+		user, password, _ := resourceTaikunCloudCredentialOpenStackGetAuth(d.Get)
 		updateBody := &models.UpdateOpenStackCommand{
 			ID:                id,
 			Name:              d.Get("name").(string),
-			OpenStackPassword: d.Get("password").(string),
-			OpenStackUser:     d.Get("user").(string),
+			OpenStackPassword: password,
+			OpenStackUser:     user,
 		}
 		updateParams := openstack.NewOpenstackUpdateParams().WithV(ApiVersion).WithBody(updateBody)
 		_, err := apiClient.Client.Openstack.OpenstackUpdate(updateParams, apiClient)
@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/itera-io/taikungoclient"
@@ -168,6 +169,101 @@ func TestAccResourceTaikunCloudCredentialOpenStackRename(t *testing.T) {
 	})
 }
 
+const testAccResourceTaikunCloudCredentialOpenStackApplicationCredentialConfig = `
+resource "taikun_cloud_credential_openstack" "foo" {
+  name = "%s"
+
+  application_credential_id     = "%s"
+  application_credential_secret = "%s"
+}
+`
+
+func TestAccResourceTaikunCloudCredentialOpenStackApplicationCredential(t *testing.T) {
+	cloudCredentialName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckOpenStackApplicationCredential(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunCloudCredentialOpenStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunCloudCredentialOpenStackApplicationCredentialConfig,
+					cloudCredentialName,
+					os.Getenv("OS_APPLICATION_CREDENTIAL_ID"),
+					os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunCloudCredentialOpenStackExists,
+					resource.TestCheckResourceAttr("taikun_cloud_credential_openstack.foo", "name", cloudCredentialName),
+					resource.TestCheckResourceAttr("taikun_cloud_credential_openstack.foo", "application_credential_id", os.Getenv("OS_APPLICATION_CREDENTIAL_ID")),
+					resource.TestCheckResourceAttr("taikun_cloud_credential_openstack.foo", "application_credential_secret", os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET")),
+					resource.TestCheckResourceAttr("taikun_cloud_credential_openstack.foo", "url", os.Getenv("OS_AUTH_URL")),
+					resource.TestCheckResourceAttr("taikun_cloud_credential_openstack.foo", "region", os.Getenv("OS_REGION_NAME")),
+					resource.TestCheckResourceAttrSet("taikun_cloud_credential_openstack.foo", "project_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceTaikunCloudCredentialOpenStackValidateAuth(t *testing.T) {
+	// Configuration and values, the latter including the environment variable defaults of user and password
+	type auth struct {
+		configured map[string]bool
+		values     map[string]interface{}
+	}
+	keys := []string{"application_credential_id", "application_credential_secret", "password", "user"}
+	rawConfig := func(configured map[string]bool) cty.Value {
+		attributes := make(map[string]cty.Value)
+		for _, key := range keys {
+			attributes[key] = cty.NullVal(cty.String)
+			if configured[key] {
+				attributes[key] = cty.StringVal("foo")
+			}
+		}
+		return cty.ObjectVal(attributes)
+	}
+	getter := func(values map[string]interface{}) cloudCredentialGetter {
+		return func(key string) interface{} {
+			if value, ok := values[key]; ok {
+				return value
+			}
+			return ""
+		}
+	}
+	environmentUser := map[string]interface{}{"user": "env-user", "password": "env-password"}
+	applicationCredential := map[string]interface{}{"application_credential_id": "id", "application_credential_secret": "secret"}
+
+	validAuths := []auth{
+		{map[string]bool{"user": true, "password": true}, map[string]interface{}{"user": "user", "password": "password"}},
+		{map[string]bool{}, environmentUser},
+		{map[string]bool{"application_credential_id": true, "application_credential_secret": true}, applicationCredential},
+		{map[string]bool{"application_credential_id": true, "application_credential_secret": true}, map[string]interface{}{
+			"application_credential_id": "id", "application_credential_secret": "secret", "user": "env-user", "password": "env-password",
+		}},
+	}
+	for _, validAuth := range validAuths {
+		if err := resourceTaikunCloudCredentialOpenStackValidateAuth(rawConfig(validAuth.configured), getter(validAuth.values)); err != nil {
+			t.Errorf("expected configuration %v to be valid, got %s", validAuth.configured, err)
+		}
+	}
+
+	invalidAuths := []auth{
+		{map[string]bool{}, map[string]interface{}{}},
+		{map[string]bool{"user": true}, map[string]interface{}{"user": "user"}},
+		{map[string]bool{"user": true, "application_credential_id": true, "application_credential_secret": true}, applicationCredential},
+		{map[string]bool{"password": true, "application_credential_id": true, "application_credential_secret": true}, applicationCredential},
+	}
+	for _, invalidAuth := range invalidAuths {
+		if err := resourceTaikunCloudCredentialOpenStackValidateAuth(rawConfig(invalidAuth.configured), getter(invalidAuth.values)); err == nil {
+			t.Errorf("expected configuration %v to be invalid", invalidAuth.configured)
+		}
+	}
+}
+
 func testAccCheckTaikunCloudCredentialOpenStackExists(state *terraform.State) error {
 	client := testAccProvider.Meta().(*taikungoclient.Client)
 
@@ -219,3 +315,24 @@ func testAccCheckTaikunCloudCredentialOpenStackDestroy(state *terraform.State) e
 
 	return nil
 }
+
+func TestResourceTaikunCloudCredentialOpenStackSwitchesAuth(t *testing.T) {
+	testCases := []struct {
+		oldID    string
+		newID    string
+		oldUser  string
+		switches bool
+	}{
+		{"", "id", "user", true},
+		{"id", "", "", true},
+		{"id", "other-id", "", false},
+		// Imported cloud credential using application credentials
+		{"", "id", "id", false},
+	}
+
+	for _, testCase := range testCases {
+		if switches := resourceTaikunCloudCredentialOpenStackSwitchesAuth(testCase.oldID, testCase.newID, testCase.oldUser); switches != testCase.switches {
+			t.Errorf("expected %q to %q with user %q to switch authentication methods: %t, got %t", testCase.oldID, testCase.newID, testCase.oldUser, testCase.switches, switches)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package taikun
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/alerting_profiles"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+// alertingProfileWebhooksMutex is keyed by alerting profile ID.
+// Since Taikun replaces all the webhooks of an alerting profile at once, the
+// alerting profile and its webhook notification channels must not update them
+// concurrently, or they would overwrite each other's changes.
+var alertingProfileWebhooksMutex keyedMutex
+
+func notificationChannelAlertingProfileIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:      "ID of the alerting profile notifying the channel.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: stringIsInt,
+	}
+}
+
+// parseNotificationChannelId splits the ID of a notification channel into the
+// ID of its alerting profile and the channel's own identifier, which may
+// contain slashes
+func parseNotificationChannelId(id string, resourceName string) (int32, string, error) {
+	list := strings.SplitN(id, "/", 2)
+	if len(list) != 2 || list[1] == "" {
+		return 0, "", fmt.Errorf("unable to determine %s ID", resourceName)
+	}
+
+	alertingProfileId, err := atoi32(list[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to determine %s ID", resourceName)
+	}
+
+	return alertingProfileId, list[1], nil
+}
+
+// updateAlertingProfileWebhooks replaces the webhooks of an alerting profile
+// with the result of modify, which is given its current webhooks and returns
+// nil to leave them unchanged
+func updateAlertingProfileWebhooks(alertingProfileId int32, modify func([]*models.AlertingWebhookDto) ([]*models.AlertingWebhookDto, error), apiClient *taikungoclient.Client) error {
+	unlock := alertingProfileWebhooksMutex.Lock(alertingProfileId)
+	defer unlock()
+
+	webhooks, err := getAlertingProfileWebhooks(alertingProfileId, apiClient)
+	if err != nil {
+		return err
+	}
+	webhooks, err = modify(webhooks)
+	if err != nil || webhooks == nil {
+		return err
+	}
+
+	params := alerting_profiles.NewAlertingProfilesAssignWebhooksParams().WithV(ApiVersion).WithID(alertingProfileId).WithBody(webhooks)
+	_, err = apiClient.Client.AlertingProfiles.AlertingProfilesAssignWebhooks(params, apiClient)
+	return err
+}
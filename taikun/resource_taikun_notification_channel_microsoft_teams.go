@@ -0,0 +1,167 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/alerting_integrations"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+const microsoftTeamsIntegrationType = "MicrosoftTeams"
+
+func resourceTaikunNotificationChannelMicrosoftTeamsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"alerting_profile_id": notificationChannelAlertingProfileIDSchema(),
+		"url": {
+			Description:  "URL of the Microsoft Teams incoming webhook.",
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+}
+
+func resourceTaikunNotificationChannelMicrosoftTeams() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Taikun Notification Channel - Microsoft Teams",
+		CreateContext: resourceTaikunNotificationChannelMicrosoftTeamsCreate,
+		ReadContext:   generateResourceTaikunNotificationChannelMicrosoftTeamsReadWithoutRetries(),
+		UpdateContext: resourceTaikunNotificationChannelMicrosoftTeamsUpdate,
+		DeleteContext: resourceTaikunNotificationChannelMicrosoftTeamsDelete,
+		Schema:        resourceTaikunNotificationChannelMicrosoftTeamsSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaikunNotificationChannelMicrosoftTeamsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	alertingProfileId, err := atoi32(d.Get("alerting_profile_id").(string))
+	if err != nil {
+		return diag.Errorf("alerting_profile_id isn't valid: %s", d.Get("alerting_profile_id").(string))
+	}
+
+	body := &models.CreateAlertingIntegrationCommand{
+		AlertingIntegrationType: getAlertingIntegrationType(microsoftTeamsIntegrationType),
+		AlertingProfileID:       alertingProfileId,
+		URL:                     d.Get("url").(string),
+	}
+	params := alerting_integrations.NewAlertingIntegrationsCreateParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.AlertingIntegrations.AlertingIntegrationsCreate(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", alertingProfileId, response.Payload.ID))
+
+	return readAfterCreateWithRetries(generateResourceTaikunNotificationChannelMicrosoftTeamsReadWithRetries(), ctx, d, meta)
+}
+
+func generateResourceTaikunNotificationChannelMicrosoftTeamsReadWithRetries() schema.ReadContextFunc {
+	return generateResourceTaikunNotificationChannelMicrosoftTeamsRead(true)
+}
+func generateResourceTaikunNotificationChannelMicrosoftTeamsReadWithoutRetries() schema.ReadContextFunc {
+	return generateResourceTaikunNotificationChannelMicrosoftTeamsRead(false)
+}
+func generateResourceTaikunNotificationChannelMicrosoftTeamsRead(withRetries bool) schema.ReadContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		apiClient := meta.(*taikungoclient.Client)
+
+		id := d.Id()
+		d.SetId("")
+		alertingProfileId, integrationId, err := parseNotificationChannelMicrosoftTeamsId(id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		params := alerting_integrations.NewAlertingIntegrationsListParams().WithV(ApiVersion).WithAlertingProfileID(alertingProfileId)
+		response, err := apiClient.Client.AlertingIntegrations.AlertingIntegrationsList(params, apiClient)
+		if err != nil {
+			if _, ok := err.(*alerting_integrations.AlertingIntegrationsListNotFound); ok && !withRetries {
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		for _, rawIntegration := range response.Payload {
+			if rawIntegration.ID == integrationId && rawIntegration.AlertingIntegrationType == microsoftTeamsIntegrationType {
+				err := setResourceDataFromMap(d, map[string]interface{}{
+					"alerting_profile_id": i32toa(alertingProfileId),
+					"url":                 rawIntegration.URL,
+				})
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.SetId(id)
+				return nil
+			}
+		}
+
+		if withRetries {
+			d.SetId(id)
+			return diag.Errorf(notFoundAfterCreateOrUpdateError)
+		}
+		return nil
+	}
+}
+
+func resourceTaikunNotificationChannelMicrosoftTeamsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	alertingProfileId, integrationId, err := parseNotificationChannelMicrosoftTeamsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := &models.EditAlertingIntegrationCommand{
+		AlertingIntegrationType: getAlertingIntegrationType(microsoftTeamsIntegrationType),
+		AlertingProfileID:       alertingProfileId,
+		ID:                      integrationId,
+		URL:                     d.Get("url").(string),
+	}
+	params := alerting_integrations.NewAlertingIntegrationsEditParams().WithV(ApiVersion).WithBody(body)
+	if _, err := apiClient.Client.AlertingIntegrations.AlertingIntegrationsEdit(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAfterUpdateWithRetries(generateResourceTaikunNotificationChannelMicrosoftTeamsReadWithRetries(), ctx, d, meta)
+}
+
+func resourceTaikunNotificationChannelMicrosoftTeamsDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	_, integrationId, err := parseNotificationChannelMicrosoftTeamsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := alerting_integrations.NewAlertingIntegrationsDeleteParams().WithV(ApiVersion).WithID(integrationId)
+	if _, _, err := apiClient.Client.AlertingIntegrations.AlertingIntegrationsDelete(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseNotificationChannelMicrosoftTeamsId(id string) (int32, int32, error) {
+	alertingProfileId, rawIntegrationId, err := parseNotificationChannelId(id, "taikun_notification_channel_microsoft_teams")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	integrationId, err := atoi32(rawIntegrationId)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to determine taikun_notification_channel_microsoft_teams ID")
+	}
+
+	return alertingProfileId, integrationId, nil
+}
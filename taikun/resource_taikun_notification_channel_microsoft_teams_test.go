@@ -0,0 +1,57 @@
+package taikun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccResourceTaikunNotificationChannelMicrosoftTeamsConfig = `
+resource "taikun_alerting_profile" "foo" {
+  name                        = "%s"
+  reminder                    = "Daily"
+  non_exclusive_sub_resources = true
+}
+
+resource "taikun_notification_channel_microsoft_teams" "foo" {
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+  url                 = "%s"
+}
+`
+
+func TestAccResourceTaikunNotificationChannelMicrosoftTeams(t *testing.T) {
+	name := randomTestName()
+	url := randomURL()
+	newURL := randomURL()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAlertingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunNotificationChannelMicrosoftTeamsConfig, name, url),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAlertingProfileExists,
+					resource.TestCheckResourceAttr("taikun_alerting_profile.foo", "integration.#", "0"),
+					resource.TestCheckResourceAttrPair("taikun_notification_channel_microsoft_teams.foo", "alerting_profile_id", "taikun_alerting_profile.foo", "id"),
+					resource.TestCheckResourceAttr("taikun_notification_channel_microsoft_teams.foo", "url", url),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunNotificationChannelMicrosoftTeamsConfig, name, newURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAlertingProfileExists,
+					resource.TestCheckResourceAttr("taikun_alerting_profile.foo", "integration.#", "0"),
+					resource.TestCheckResourceAttr("taikun_notification_channel_microsoft_teams.foo", "url", newURL),
+				),
+			},
+			{
+				ResourceName:      "taikun_notification_channel_microsoft_teams.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,193 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/alerting_profiles"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func resourceTaikunNotificationChannelWebhookSchema() map[string]*schema.Schema {
+	webhookSchema := resourceTaikunAlertingProfileSchema()["webhook"].Elem.(*schema.Resource).Schema
+	webhookSchema["alerting_profile_id"] = notificationChannelAlertingProfileIDSchema()
+	webhookSchema["url"].ForceNew = true
+	webhookSchema["url"].ValidateFunc = validation.IsURLWithHTTPorHTTPS
+	return webhookSchema
+}
+
+func resourceTaikunNotificationChannelWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Taikun Notification Channel - Webhook",
+		CreateContext: resourceTaikunNotificationChannelWebhookCreate,
+		ReadContext:   generateResourceTaikunNotificationChannelWebhookReadWithoutRetries(),
+		UpdateContext: resourceTaikunNotificationChannelWebhookUpdate,
+		DeleteContext: resourceTaikunNotificationChannelWebhookDelete,
+		Schema:        resourceTaikunNotificationChannelWebhookSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaikunNotificationChannelWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	alertingProfileId, err := atoi32(d.Get("alerting_profile_id").(string))
+	if err != nil {
+		return diag.Errorf("alerting_profile_id isn't valid: %s", d.Get("alerting_profile_id").(string))
+	}
+	url := d.Get("url").(string)
+
+	err = updateAlertingProfileWebhooks(alertingProfileId, func(webhooks []*models.AlertingWebhookDto) ([]*models.AlertingWebhookDto, error) {
+		for _, webhook := range webhooks {
+			if webhook.URL == url {
+				return nil, fmt.Errorf("alerting profile %d already has a webhook with the URL %s", alertingProfileId, url)
+			}
+		}
+		return append(webhooks, &models.AlertingWebhookDto{
+			Headers: getNotificationChannelWebhookHeaderDTOs(d),
+			URL:     url,
+		}), nil
+	}, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", alertingProfileId, url))
+
+	return readAfterCreateWithRetries(generateResourceTaikunNotificationChannelWebhookReadWithRetries(), ctx, d, meta)
+}
+
+func generateResourceTaikunNotificationChannelWebhookReadWithRetries() schema.ReadContextFunc {
+	return generateResourceTaikunNotificationChannelWebhookRead(true)
+}
+func generateResourceTaikunNotificationChannelWebhookReadWithoutRetries() schema.ReadContextFunc {
+	return generateResourceTaikunNotificationChannelWebhookRead(false)
+}
+func generateResourceTaikunNotificationChannelWebhookRead(withRetries bool) schema.ReadContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		apiClient := meta.(*taikungoclient.Client)
+
+		id := d.Id()
+		d.SetId("")
+		alertingProfileId, url, err := parseNotificationChannelId(id, "taikun_notification_channel_webhook")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		params := alerting_profiles.NewAlertingProfilesListParams().WithV(ApiVersion).WithID(&alertingProfileId)
+		response, err := apiClient.Client.AlertingProfiles.AlertingProfilesList(params, apiClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(response.Payload.Data) != 1 {
+			if withRetries {
+				d.SetId(id)
+				return diag.Errorf(notFoundAfterCreateOrUpdateError)
+			}
+			return nil
+		}
+
+		for _, webhook := range getAlertingProfileWebhookResourceFromWebhookDTOs(response.Payload.Data[0].Webhooks) {
+			if webhook["url"] == url {
+				keepAlertingProfileWebhookHeaderValues(webhook, []interface{}{
+					map[string]interface{}{
+						"header": d.Get("header"),
+						"url":    url,
+					},
+				})
+				webhook["alerting_profile_id"] = i32toa(alertingProfileId)
+				if err := setResourceDataFromMap(d, webhook); err != nil {
+					return diag.FromErr(err)
+				}
+				d.SetId(id)
+				return nil
+			}
+		}
+
+		if withRetries {
+			d.SetId(id)
+			return diag.Errorf(notFoundAfterCreateOrUpdateError)
+		}
+		return nil
+	}
+}
+
+func resourceTaikunNotificationChannelWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	alertingProfileId, url, err := parseNotificationChannelId(d.Id(), "taikun_notification_channel_webhook")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateAlertingProfileWebhooks(alertingProfileId, func(webhooks []*models.AlertingWebhookDto) ([]*models.AlertingWebhookDto, error) {
+		for _, webhook := range webhooks {
+			if webhook.URL == url {
+				webhook.Headers = getNotificationChannelWebhookHeaderDTOs(d)
+			}
+		}
+		return webhooks, nil
+	}, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAfterUpdateWithRetries(generateResourceTaikunNotificationChannelWebhookReadWithRetries(), ctx, d, meta)
+}
+
+func resourceTaikunNotificationChannelWebhookDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	alertingProfileId, url, err := parseNotificationChannelId(d.Id(), "taikun_notification_channel_webhook")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := alerting_profiles.NewAlertingProfilesListParams().WithV(ApiVersion).WithID(&alertingProfileId)
+	response, err := apiClient.Client.AlertingProfiles.AlertingProfilesList(params, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(response.Payload.Data) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	err = updateAlertingProfileWebhooks(alertingProfileId, func(webhooks []*models.AlertingWebhookDto) ([]*models.AlertingWebhookDto, error) {
+		otherWebhooks := make([]*models.AlertingWebhookDto, 0, len(webhooks))
+		for _, webhook := range webhooks {
+			if webhook.URL != url {
+				otherWebhooks = append(otherWebhooks, webhook)
+			}
+		}
+		if len(otherWebhooks) == len(webhooks) {
+			return nil, nil
+		}
+		return otherWebhooks, nil
+	}, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getNotificationChannelWebhookHeaderDTOs(d *schema.ResourceData) []*models.WebhookHeaderDto {
+	headers := d.Get("header").(*schema.Set).List()
+	headerDTOs := make([]*models.WebhookHeaderDto, len(headers))
+	for i, headerData := range headers {
+		header := headerData.(map[string]interface{})
+		headerDTOs[i] = &models.WebhookHeaderDto{
+			Key:   header["key"].(string),
+			Value: header["value"].(string),
+		}
+	}
+	return headerDTOs
+}
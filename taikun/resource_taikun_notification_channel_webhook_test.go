@@ -0,0 +1,121 @@
+package taikun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccResourceTaikunNotificationChannelWebhookConfig = `
+resource "taikun_alerting_profile" "foo" {
+  name                        = "%s"
+  reminder                    = "Daily"
+  non_exclusive_sub_resources = true
+
+  webhook {
+    url = "%s"
+  }
+}
+
+resource "taikun_notification_channel_webhook" "foo" {
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+  url                 = "%s"
+
+  header {
+    key   = "Authorization"
+    value = "%s"
+  }
+}
+`
+
+func TestAccResourceTaikunNotificationChannelWebhook(t *testing.T) {
+	name := randomTestName()
+	profileWebhookURL := randomURL()
+	url := randomURL()
+	token := randomString()
+	newToken := randomString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAlertingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunNotificationChannelWebhookConfig, name, profileWebhookURL, url, token),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAlertingProfileExists,
+					resource.TestCheckResourceAttr("taikun_alerting_profile.foo", "webhook.#", "1"),
+					resource.TestCheckResourceAttrPair("taikun_notification_channel_webhook.foo", "alerting_profile_id", "taikun_alerting_profile.foo", "id"),
+					resource.TestCheckResourceAttr("taikun_notification_channel_webhook.foo", "url", url),
+					resource.TestCheckResourceAttr("taikun_notification_channel_webhook.foo", "header.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunNotificationChannelWebhookConfig, name, profileWebhookURL, url, newToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAlertingProfileExists,
+					resource.TestCheckResourceAttr("taikun_alerting_profile.foo", "webhook.#", "1"),
+					resource.TestCheckResourceAttr("taikun_notification_channel_webhook.foo", "header.#", "1"),
+				),
+			},
+			{
+				ResourceName:            "taikun_notification_channel_webhook.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"header"},
+			},
+		},
+	})
+}
+
+const testAccResourceTaikunNotificationChannelWebhookMultipleConfig = `
+resource "taikun_alerting_profile" "foo" {
+  name                        = "%s"
+  reminder                    = "Daily"
+  non_exclusive_sub_resources = true
+}
+
+resource "taikun_notification_channel_webhook" "foo" {
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+  url                 = "%s"
+}
+
+resource "taikun_notification_channel_webhook" "bar" {
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+  url                 = "%s"
+}
+`
+
+func TestAccResourceTaikunNotificationChannelWebhookMultiple(t *testing.T) {
+	name := randomTestName()
+	url := randomURL()
+	otherURL := randomURL()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunAlertingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Both channels are created concurrently on the same alerting profile
+				Config: fmt.Sprintf(testAccResourceTaikunNotificationChannelWebhookMultipleConfig, name, url, otherURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunAlertingProfileExists,
+					resource.TestCheckResourceAttr("taikun_notification_channel_webhook.foo", "url", url),
+					resource.TestCheckResourceAttr("taikun_notification_channel_webhook.bar", "url", otherURL),
+				),
+			},
+			{
+				ResourceName:      "taikun_notification_channel_webhook.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "taikun_notification_channel_webhook.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
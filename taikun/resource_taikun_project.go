@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/itera-io/taikungoclient"
 	"github.com/itera-io/taikungoclient/client/access_profiles"
@@ -279,6 +280,21 @@ func resourceTaikunProjectSchema() map[string]*schema.Schema {
 				Schema: taikunVMSchema(),
 			},
 		},
+		"vm_concurrency": {
+			Description:  "Maximum number of virtual machine and disk operations sent to the API concurrently. Can be set with the `TAIKUN_VM_CONCURRENCY` environment variable.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			DefaultFunc:  vmConcurrencyDefaultFunc,
+			ValidateFunc: validation.IntBetween(1, 50),
+		},
+		"vm_group": {
+			Description: "Groups of identical virtual machines.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: taikunVMGroupSchema(),
+			},
+		},
 	}
 }
 
@@ -326,6 +342,10 @@ func resourceTaikunProject() *schema.Resource {
 
 				return nil
 			},
+			resourceTaikunProjectValidateCloudInitSize,
+			resourceTaikunProjectValidateVMGroupNames,
+			resourceTaikunProjectValidateWindowsVMs,
+			resourceTaikunProjectValidateKubernetesVersion,
 		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(80 * time.Minute),
@@ -337,6 +357,31 @@ func resourceTaikunProject() *schema.Resource {
 	}
 }
 
+// resourceTaikunProjectValidateKubernetesVersion checks at plan time that
+// Taikun supports the Kubernetes version of a new project
+func resourceTaikunProjectValidateKubernetesVersion(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The version is only sent at creation, later upgrades are done by Taikun
+	if d.Id() != "" || !d.NewValueKnown("kubernetes_version") {
+		return nil
+	}
+	kubernetesVersion, kubernetesVersionIsSet := d.GetOk("kubernetes_version")
+	if !kubernetesVersionIsSet {
+		return nil
+	}
+
+	apiClient := meta.(*taikungoclient.Client)
+	supportedVersions, err := kubernetesVersionsList(apiClient)
+	if err != nil {
+		return err
+	}
+	for _, supportedVersion := range supportedVersions {
+		if supportedVersion == kubernetesVersion {
+			return nil
+		}
+	}
+	return fmt.Errorf("Kubernetes version %s is not supported by Taikun, expected one of: %s", kubernetesVersion, strings.Join(supportedVersions, ", "))
+}
+
 func resourceTaikunProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiClient := meta.(*taikungoclient.Client)
 	ctx, cancel := context.WithTimeout(ctx, 80*time.Minute)
@@ -472,28 +517,41 @@ func resourceTaikunProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if _, vmIsSet := d.GetOk("vm"); vmIsSet {
+	// Warnings of the VM operations are returned along with the project
+	var vmDiags diag.Diagnostics
+	_, vmIsSet := d.GetOk("vm")
+	_, vmGroupIsSet := d.GetOk("vm_group")
+	if vmIsSet || vmGroupIsSet {
 
-		if err := resourceTaikunProjectSetVMs(d, apiClient, projectID); err != nil {
-			return diag.FromErr(err)
+		if vmIsSet {
+			vmDiags = append(vmDiags, resourceTaikunProjectSetVMs(d, apiClient, projectID)...)
 		}
 
+		if vmGroupIsSet {
+			vmDiags = append(vmDiags, resourceTaikunProjectSetVMGroups(d, apiClient, projectID)...)
+		}
+
+		// Commit the VMs which were created successfully
 		if err := resourceTaikunProjectStandaloneCommit(apiClient, projectID); err != nil {
-			return diag.FromErr(err)
+			return append(vmDiags, diag.FromErr(err)...)
 		}
 
 		if err := resourceTaikunProjectWaitForStatus(ctx, []string{"Ready"}, []string{"Updating", "Pending"}, apiClient, projectID); err != nil {
-			return diag.FromErr(err)
+			return append(vmDiags, diag.FromErr(err)...)
+		}
+
+		if vmDiags.HasError() {
+			return vmDiags
 		}
 	}
 
 	if d.Get("lock").(bool) {
 		if err := resourceTaikunProjectLock(projectID, true, apiClient); err != nil {
-			return diag.FromErr(err)
+			return append(vmDiags, diag.FromErr(err)...)
 		}
 	}
 
-	return readAfterCreateWithRetries(generateResourceTaikunProjectReadWithRetries(), ctx, d, meta)
+	return append(vmDiags, readAfterCreateWithRetries(generateResourceTaikunProjectReadWithRetries(), ctx, d, meta)...)
 }
 func generateResourceTaikunProjectReadWithRetries() schema.ReadContextFunc {
 	return generateResourceTaikunProjectRead(true)
@@ -559,23 +617,101 @@ func generateResourceTaikunProjectRead(withRetries bool) schema.ReadContextFunc
 		}
 
 		projectMap := flattenTaikunProject(projectDetailsDTO, serverList, vmList, boundFlavorDTOs, boundImageDTOs, quotaResponse.Payload.Data[0])
-		usernames := resourceTaikunProjectGetResourceDataVmUsernames(d)
+		resourceTaikunProjectFlattenVMGroups(ctx, d, projectMap)
+		resourceTaikunProjectDecodeVmCloudInits(d, projectMap)
+		var diags diag.Diagnostics
+		if !withRetries {
+			diags = resourceTaikunProjectCheckAlertingProfileConflict(d, projectMap)
+		}
+		unreadableProperties := resourceTaikunProjectGetResourceDataVmUnreadableProperties(d)
 		if err := setResourceDataFromMap(d, projectMap); err != nil {
 			return diag.FromErr(err)
 		}
 
-		if err := resourceTaikunProjectRestoreResourceDataVmUsernames(d, usernames); err != nil {
+		if err := resourceTaikunProjectRestoreResourceDataVmUnreadableProperties(d, unreadableProperties); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := resourceTaikunProjectSetVmWindowsPasswords(ctx, d, apiClient); err != nil {
 			return diag.FromErr(err)
 		}
 
+		// Not stored by the API, fall back to the default when importing
+		if d.Get("vm_concurrency").(int) == 0 {
+			vmConcurrency, err := vmConcurrencyDefaultFunc()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("vm_concurrency", vmConcurrency); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		d.SetId(id)
 
+		return diags
+	}
+}
+
+// resourceTaikunProjectCheckAlertingProfileConflict warns when the alerting
+// profile of the project changed outside of the resource, which happens when
+// it is attached by a taikun_project_alerting_profile_attachment. The next
+// apply would otherwise silently detach it, and both resources would keep
+// undoing each other's changes.
+func resourceTaikunProjectCheckAlertingProfileConflict(d *schema.ResourceData, projectMap map[string]interface{}) diag.Diagnostics {
+	// Nothing to compare with when importing
+	if d.Get("name").(string) == "" {
+		return nil
+	}
+
+	previousAlertingProfileID := d.Get("alerting_profile_id").(string)
+	alertingProfileID, _ := projectMap["alerting_profile_id"].(string)
+	if previousAlertingProfileID == alertingProfileID {
 		return nil
 	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "The project's alerting profile was changed outside of taikun_project",
+			Detail: fmt.Sprintf(
+				"The alerting profile of project %s changed from %q to %q. If it is attached by a taikun_project_alerting_profile_attachment, leave alerting_profile_id unset and add it to the project's lifecycle.ignore_changes, otherwise the next apply restores the project's alerting_profile_id.",
+				d.Get("name"), previousAlertingProfileID, alertingProfileID,
+			),
+			AttributePath: cty.GetAttrPath("alerting_profile_id"),
+		},
+	}
+}
+
+// resourceTaikunProjectDecodeVmCloudInits decodes the cloud init of the VMs
+// whose prior state had cloud_init_gzip enabled. The API returns the cloud init
+// as it was sent, and a plain cloud init may happen to be valid base64 and gzip,
+// so only the prior state tells whether it was encoded.
+func resourceTaikunProjectDecodeVmCloudInits(d *schema.ResourceData, projectMap map[string]interface{}) {
+	gzipEnabled := map[string]bool{}
+	vmList, _ := d.Get("vm").([]interface{})
+	for _, vmData := range vmList {
+		vm, ok := vmData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if vmId, ok := vm["id"].(string); ok && vmId != "" {
+			gzipEnabled[vmId], _ = vm["cloud_init_gzip"].(bool)
+		}
+	}
+
+	for _, vm := range projectMap["vm"].([]map[string]interface{}) {
+		if gzipEnabled[vm["id"].(string)] {
+			vm["cloud_init"], vm["cloud_init_gzip"] = gzipBase64Decode(vm["cloud_init"].(string))
+		}
+	}
 }
 
-func resourceTaikunProjectGetResourceDataVmUsernames(d *schema.ResourceData) (usernames map[string]string) {
-	usernames = map[string]string{}
+// Properties of a VM which cannot be read back from the API and must therefore be preserved across reads
+var vmUnreadablePropertyKeys = []string{"username", "windows_password", "windows_private_key"}
+
+func resourceTaikunProjectGetResourceDataVmUnreadableProperties(d *schema.ResourceData) (properties map[string]map[string]interface{}) {
+	properties = map[string]map[string]interface{}{}
 
 	vmListData, ok := d.GetOk("vm")
 	if !ok {
@@ -602,21 +738,20 @@ func resourceTaikunProjectGetResourceDataVmUsernames(d *schema.ResourceData) (us
 			continue
 		}
 
-		usernameData, ok := vm["username"]
-		if !ok {
-			continue
-		}
-
-		if username, ok := usernameData.(string); ok {
-			usernames[vmId] = username
+		vmProperties := map[string]interface{}{}
+		for _, key := range vmUnreadablePropertyKeys {
+			if value, ok := vm[key].(string); ok {
+				vmProperties[key] = value
+			}
 		}
+		properties[vmId] = vmProperties
 	}
 
-	return usernames
+	return properties
 }
 
-func resourceTaikunProjectRestoreResourceDataVmUsernames(d *schema.ResourceData, usernames map[string]string) error {
-	if len(usernames) == 0 {
+func resourceTaikunProjectRestoreResourceDataVmUnreadableProperties(d *schema.ResourceData, properties map[string]map[string]interface{}) error {
+	if len(properties) == 0 {
 		return nil
 	}
 
@@ -630,26 +765,24 @@ func resourceTaikunProjectRestoreResourceDataVmUsernames(d *schema.ResourceData,
 		return nil
 	}
 
-	for usernameVmId, username := range usernames {
-		for _, vmData := range vmList {
-			vm, ok := vmData.(map[string]interface{})
-			if !ok {
-				return nil
-			}
+	for _, vmData := range vmList {
+		vm, ok := vmData.(map[string]interface{})
+		if !ok {
+			return nil
+		}
 
-			vmIdData, ok := vm["id"]
-			if !ok {
-				continue
-			}
+		vmIdData, ok := vm["id"]
+		if !ok {
+			continue
+		}
 
-			vmId, ok := vmIdData.(string)
-			if !ok {
-				continue
-			}
+		vmId, ok := vmIdData.(string)
+		if !ok {
+			continue
+		}
 
-			if vmId == usernameVmId {
-				vm["username"] = username
-			}
+		for key, value := range properties[vmId] {
+			vm[key] = value
 		}
 	}
 
@@ -832,20 +965,29 @@ func resourceTaikunProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// Warnings of the VM operations are returned along with the project
+	var vmDiags diag.Diagnostics
 	if d.HasChange("vm") {
-		err = resourceTaikunProjectUpdateVMs(ctx, d, apiClient, id)
-		if err != nil {
-			return diag.FromErr(err)
+		vmDiags = append(vmDiags, resourceTaikunProjectUpdateVMs(ctx, d, apiClient, id)...)
+		if vmDiags.HasError() {
+			return vmDiags
+		}
+	}
+
+	if d.HasChange("vm_group") {
+		vmDiags = append(vmDiags, resourceTaikunProjectUpdateVMGroups(ctx, d, apiClient, id)...)
+		if vmDiags.HasError() {
+			return vmDiags
 		}
 	}
 
 	if d.Get("lock").(bool) {
 		if err := resourceTaikunProjectLock(id, true, apiClient); err != nil {
-			return diag.FromErr(err)
+			return append(vmDiags, diag.FromErr(err)...)
 		}
 	}
 
-	return readAfterUpdateWithRetries(generateResourceTaikunProjectReadWithRetries(), ctx, d, meta)
+	return append(vmDiags, readAfterUpdateWithRetries(generateResourceTaikunProjectReadWithRetries(), ctx, d, meta)...)
 }
 
 func resourceTaikunProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -874,7 +1016,11 @@ func resourceTaikunProjectDelete(ctx context.Context, d *schema.ResourceData, me
 			return diag.FromErr(err)
 		}
 	}
-	if vms := d.Get("vm").([]interface{}); len(vms) != 0 {
+	vms := d.Get("vm").([]interface{})
+	for _, group := range d.Get("vm_group").([]interface{}) {
+		vms = append(vms, group.(map[string]interface{})["vm"].([]interface{})...)
+	}
+	if len(vms) != 0 {
 		err = resourceTaikunProjectPurgeVMs(vms, apiClient, id)
 		if err != nil {
 			return diag.FromErr(err)
@@ -1042,19 +1188,26 @@ func flattenTaikunProject(
 
 	vms := make([]map[string]interface{}, 0)
 	for _, vm := range vmListDTO {
+		privateIPs, privateIPv6Addresses := splitIPAddresses(vm.IPAddress)
+		publicIPs, publicIPv6Addresses := splitIPAddresses(vm.PublicIP)
 		vmMap := map[string]interface{}{
 			"access_ip":             vm.PublicIP,
 			"cloud_init":            vm.CloudInit,
+			"cloud_init_gzip":       false,
 			"created_by":            vm.CreatedBy,
 			"flavor":                vm.TargetFlavor,
 			"id":                    i32toa(vm.ID),
 			"image_id":              vm.ImageID,
 			"image_name":            vm.ImageName,
 			"ip":                    vm.IPAddress,
+			"ipv6_addresses":        append(privateIPv6Addresses, publicIPv6Addresses...),
+			"is_windows":            vm.IsWindows,
 			"last_modified":         vm.LastModified,
 			"last_modified_by":      vm.LastModifiedBy,
 			"name":                  vm.Name,
+			"private_ips":           privateIPs,
 			"public_ip":             vm.PublicIPEnabled,
+			"public_ips":            publicIPs,
 			"standalone_profile_id": i32toa(vm.Profile.ID),
 			"status":                vm.Status,
 			"volume_size":           vm.VolumeSize,
@@ -1231,7 +1384,8 @@ func resourceTaikunProjectGetCloudType(cloudCredentialID int32, apiClient *taiku
 	if len(response.Payload.Google) == 1 {
 		return cloudTypeGCP, nil
 	}
-	return "", fmt.Errorf("cloud credential with ID %d not found", cloudCredentialID)
+	// Credentials of other cloud types, e.g. vSphere, are not listed by the API version used by the provider
+	return "", fmt.Errorf("cloud credential with ID %d not found, the provider only supports %s, %s, %s and %s cloud credentials", cloudCredentialID, cloudTypeAWS, cloudTypeAzure, cloudTypeGCP, cloudTypeOpenStack)
 }
 
 const defaultAccessProfileName = "default"
@@ -0,0 +1,192 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/alerting_profiles"
+	"github.com/itera-io/taikungoclient/client/servers"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func resourceTaikunProjectAlertingProfileAttachmentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"alerting_profile_id": {
+			Description:      "ID of the alerting profile.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: stringIsInt,
+		},
+		"project_id": {
+			Description:      "ID of the project.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: stringIsInt,
+		},
+	}
+}
+
+func resourceTaikunProjectAlertingProfileAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Taikun Project-Alerting Profile Attachment",
+		CreateContext: resourceTaikunProjectAlertingProfileAttachmentCreate,
+		ReadContext:   generateResourceTaikunProjectAlertingProfileAttachmentReadWithoutRetries(),
+		DeleteContext: resourceTaikunProjectAlertingProfileAttachmentDelete,
+		Schema:        resourceTaikunProjectAlertingProfileAttachmentSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaikunProjectAlertingProfileAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	projectId, err := atoi32(d.Get("project_id").(string))
+	if err != nil {
+		return diag.Errorf("project_id isn't valid: %s", d.Get("project_id").(string))
+	}
+	alertingProfileId, err := atoi32(d.Get("alerting_profile_id").(string))
+	if err != nil {
+		return diag.Errorf("alerting_profile_id isn't valid: %s", d.Get("alerting_profile_id").(string))
+	}
+
+	// A project has a single alerting profile, either set by the project's
+	// alerting_profile_id or by an attachment
+	detailsParams := servers.NewServersDetailsParams().WithV(ApiVersion).WithProjectID(projectId)
+	detailsResponse, err := apiClient.Client.Servers.ServersDetails(detailsParams, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if attachedAlertingProfileId := detailsResponse.Payload.Project.AlertingProfileID; attachedAlertingProfileId != 0 {
+		return diag.Errorf(
+			"project %d already has the alerting profile %d attached, remove the project's alerting_profile_id or its other taikun_project_alerting_profile_attachment",
+			projectId, attachedAlertingProfileId,
+		)
+	}
+
+	body := &models.AttachDetachAlertingProfileCommand{
+		AlertingProfileID: alertingProfileId,
+		ProjectID:         projectId,
+	}
+	params := alerting_profiles.NewAlertingProfilesAttachParams().WithV(ApiVersion).WithBody(body)
+	if _, err := apiClient.Client.AlertingProfiles.AlertingProfilesAttach(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", projectId, alertingProfileId))
+
+	return readAfterCreateWithRetries(generateResourceTaikunProjectAlertingProfileAttachmentReadWithRetries(), ctx, d, meta)
+}
+
+func generateResourceTaikunProjectAlertingProfileAttachmentReadWithRetries() schema.ReadContextFunc {
+	return generateResourceTaikunProjectAlertingProfileAttachmentRead(true)
+}
+func generateResourceTaikunProjectAlertingProfileAttachmentReadWithoutRetries() schema.ReadContextFunc {
+	return generateResourceTaikunProjectAlertingProfileAttachmentRead(false)
+}
+func generateResourceTaikunProjectAlertingProfileAttachmentRead(withRetries bool) schema.ReadContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		apiClient := meta.(*taikungoclient.Client)
+
+		id := d.Id()
+		d.SetId("")
+		projectId, alertingProfileId, err := parseProjectAlertingProfileAttachmentId(id)
+		if err != nil {
+			return diag.Errorf("Error while reading taikun_project_alerting_profile_attachment : %s", err)
+		}
+
+		attached, err := resourceTaikunProjectAlertingProfileAttachmentIsAttached(projectId, alertingProfileId, apiClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !attached {
+			if withRetries {
+				d.SetId(id)
+				return diag.Errorf(notFoundAfterCreateOrUpdateError)
+			}
+			return nil
+		}
+
+		if err := d.Set("alerting_profile_id", i32toa(alertingProfileId)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("project_id", i32toa(projectId)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(id)
+		return nil
+	}
+}
+
+func resourceTaikunProjectAlertingProfileAttachmentDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiClient := meta.(*taikungoclient.Client)
+
+	projectId, alertingProfileId, err := parseProjectAlertingProfileAttachmentId(d.Id())
+	if err != nil {
+		return diag.Errorf("Error while deleting taikun_project_alerting_profile_attachment : %s", err)
+	}
+
+	// Don't detach another alerting profile attached in the meantime
+	attached, err := resourceTaikunProjectAlertingProfileAttachmentIsAttached(projectId, alertingProfileId, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !attached {
+		d.SetId("")
+		return nil
+	}
+
+	body := &models.AttachDetachAlertingProfileCommand{
+		ProjectID: projectId,
+	}
+	params := alerting_profiles.NewAlertingProfilesDetachParams().WithV(ApiVersion).WithBody(body)
+	if _, err := apiClient.Client.AlertingProfiles.AlertingProfilesDetach(params, apiClient); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceTaikunProjectAlertingProfileAttachmentIsAttached checks whether the
+// alerting profile exists and is attached to the project
+func resourceTaikunProjectAlertingProfileAttachmentIsAttached(projectId int32, alertingProfileId int32, apiClient *taikungoclient.Client) (bool, error) {
+	params := alerting_profiles.NewAlertingProfilesListParams().WithV(ApiVersion).WithID(&alertingProfileId)
+	response, err := apiClient.Client.AlertingProfiles.AlertingProfilesList(params, apiClient)
+	if err != nil {
+		return false, err
+	}
+	if len(response.Payload.Data) != 1 {
+		return false, nil
+	}
+
+	for _, project := range response.Payload.Data[0].Projects {
+		if project.ID == projectId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func parseProjectAlertingProfileAttachmentId(id string) (int32, int32, error) {
+	list := strings.Split(id, "/")
+	if len(list) != 2 {
+		return 0, 0, fmt.Errorf("unable to determine taikun_project_alerting_profile_attachment ID")
+	}
+
+	projectId, err := atoi32(list[0])
+	alertingProfileId, err2 := atoi32(list[1])
+	if err != nil || err2 != nil {
+		return 0, 0, fmt.Errorf("unable to determine taikun_project_alerting_profile_attachment ID")
+	}
+
+	return projectId, alertingProfileId, nil
+}
@@ -0,0 +1,145 @@
+package taikun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/itera-io/taikungoclient"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAccResourceTaikunProjectAlertingProfileAttachmentConfig = `
+resource "taikun_alerting_profile" "foo" {
+  name     = "%s"
+  reminder = "Daily"
+}
+
+resource "taikun_cloud_credential_aws" "foo" {
+  name = "%s"
+  availability_zone = "%s"
+}
+
+resource "taikun_project" "foo" {
+  name = "%s"
+  cloud_credential_id = resource.taikun_cloud_credential_aws.foo.id
+
+  lifecycle {
+    ignore_changes = [alerting_profile_id]
+  }
+}
+
+resource "taikun_project_alerting_profile_attachment" "foo" {
+  project_id          = resource.taikun_project.foo.id
+  alerting_profile_id = resource.taikun_alerting_profile.foo.id
+}
+`
+
+func TestAccResourceTaikunProjectAlertingProfileAttachment(t *testing.T) {
+	alertingProfileName := randomTestName()
+	cloudCredentialName := randomTestName()
+	projectName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWS(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunProjectAlertingProfileAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectAlertingProfileAttachmentConfig,
+					alertingProfileName,
+					cloudCredentialName,
+					os.Getenv("AWS_AVAILABILITY_ZONE"),
+					projectName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunProjectAlertingProfileAttachmentExists,
+					resource.TestCheckResourceAttrPair("taikun_project_alerting_profile_attachment.foo", "project_id", "taikun_project.foo", "id"),
+					resource.TestCheckResourceAttrPair("taikun_project_alerting_profile_attachment.foo", "alerting_profile_id", "taikun_alerting_profile.foo", "id"),
+				),
+			},
+			{
+				// The alerting profile is read before the attachment is created
+				Config: fmt.Sprintf(testAccResourceTaikunProjectAlertingProfileAttachmentConfig,
+					alertingProfileName,
+					cloudCredentialName,
+					os.Getenv("AWS_AVAILABILITY_ZONE"),
+					projectName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("taikun_alerting_profile.foo", "attached_project_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("taikun_alerting_profile.foo", "attached_project_ids.0", "taikun_project.foo", "id"),
+					resource.TestCheckResourceAttrPair("taikun_project.foo", "alerting_profile_id", "taikun_alerting_profile.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      "taikun_project_alerting_profile_attachment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTaikunProjectAlertingProfileAttachmentExists(state *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*taikungoclient.Client)
+
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "taikun_project_alerting_profile_attachment" {
+			continue
+		}
+
+		projectId, alertingProfileId, err := parseProjectAlertingProfileAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		attached, err := resourceTaikunProjectAlertingProfileAttachmentIsAttached(projectId, alertingProfileId, apiClient)
+		if err != nil {
+			return err
+		}
+		if !attached {
+			return fmt.Errorf("project_alerting_profile_attachment doesn't exist (id = %s)", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTaikunProjectAlertingProfileAttachmentDestroy(state *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*taikungoclient.Client)
+
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "taikun_project_alerting_profile_attachment" {
+			continue
+		}
+
+		projectId, alertingProfileId, err := parseProjectAlertingProfileAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		retryErr := resource.RetryContext(context.Background(), getReadAfterOpTimeout(false), func() *resource.RetryError {
+			attached, err := resourceTaikunProjectAlertingProfileAttachmentIsAttached(projectId, alertingProfileId, apiClient)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if attached {
+				return resource.RetryableError(errors.New("project_alerting_profile_attachment still exists"))
+			}
+			return nil
+		})
+		if timedOut(retryErr) {
+			return errors.New("project_alerting_profile_attachment still exists (timed out)")
+		}
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	return nil
+}
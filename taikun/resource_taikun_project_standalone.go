@@ -1,15 +1,26 @@
 package taikun
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
 	"reflect"
 	"regexp"
+	"strings"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/itera-io/taikungoclient"
 	"github.com/itera-io/taikungoclient/client/images"
 	"github.com/itera-io/taikungoclient/client/stand_alone"
+	"github.com/itera-io/taikungoclient/client/stand_alone_actions"
 	"github.com/itera-io/taikungoclient/client/stand_alone_vm_disks"
 	"github.com/itera-io/taikungoclient/models"
 )
@@ -22,10 +33,17 @@ func taikunVMSchema() map[string]*schema.Schema {
 			Computed:    true,
 		},
 		"cloud_init": {
-			Description: "Cloud init (updating this field will recreate the VM).",
-			Type:        schema.TypeString,
+			Description:      "Cloud init user data, e.g. a `#cloud-config` YAML document, a shell script or a MIME multipart archive (updating this field will recreate the VM).",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "",
+			ValidateDiagFunc: stringIsCloudInit,
+		},
+		"cloud_init_gzip": {
+			Description: "Whether to compress the cloud init with gzip and encode it in base64 before sending it (updating this field will recreate the VM).",
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     "",
+			Default:     false,
 		},
 		"created_by": {
 			Description: "The creator of the VM.",
@@ -114,6 +132,19 @@ func taikunVMSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"ipv6_addresses": {
+			Description: "IPv6 addresses of the VM.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"is_windows": {
+			Description: "Whether the VM runs a Windows image.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
 		"last_modified": {
 			Description: "The time and date of last modification.",
 			Type:        schema.TypeString,
@@ -130,12 +161,28 @@ func taikunVMSchema() map[string]*schema.Schema {
 			Required:     true,
 			ValidateFunc: validation.StringLenBetween(1, 52),
 		},
+		"private_ips": {
+			Description: "Private IPv4 addresses of the VM, one per network interface.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 		"public_ip": {
 			Description: "Whether a public IP will be available (updating this field will recreate the VM if the project isn't hosted on OpenStack).",
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     false,
 		},
+		"public_ips": {
+			Description: "Public IPv4 addresses of the VM.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 		"standalone_profile_id": {
 			Description:      "Standalone profile ID bound to the VM (updating this field will recreate the VM).",
 			Type:             schema.TypeString,
@@ -198,34 +245,63 @@ func taikunVMSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Computed:    true,
 		},
+		"windows_password": {
+			Description: "The Windows Administrator password (only set for Windows VMs).",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"windows_private_key": {
+			Description: "Private key in PEM format matching the standalone profile's public key, used to decrypt the Windows Administrator password (required with AWS).",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
 	}
 }
 
-func resourceTaikunProjectSetVMs(d *schema.ResourceData, apiClient *taikungoclient.Client, projectID int32) error {
+func resourceTaikunProjectSetVMs(d *schema.ResourceData, apiClient *taikungoclient.Client, projectID int32) diag.Diagnostics {
 
 	vms := d.Get("vm")
 
 	vmsList := vms.([]interface{})
-	for _, vm := range vmsList {
-		vmMap := vm.(map[string]interface{})
-
-		vmId, unreadableProperties, err := resourceTaikunProjectAddVM(vmMap, apiClient, projectID)
-		if err != nil {
-			return err
-		}
-		vmMap["id"] = vmId
-
-		for key, value := range unreadableProperties {
-			vmMap[key] = value
-		}
+	vmMaps := make([]map[string]interface{}, len(vmsList))
+	for i, vm := range vmsList {
+		vmMaps[i] = vm.(map[string]interface{})
 	}
+	diags := runOperationsConcurrently(d.Get("vm_concurrency").(int), resourceTaikunProjectAddVMOperations(vmMaps, apiClient, projectID))
 
-	err := d.Set("vm", vmsList)
-	if err != nil {
-		return err
+	// VMs which failed to be created have no ID and will be removed from the state on the next read
+	if err := d.Set("vm", vmsList); err != nil {
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	return nil
+	return diags
+}
+
+// resourceTaikunProjectAddVMOperations returns one operation per VM, each
+// setting its VM's ID and unreadable properties once it has been created
+func resourceTaikunProjectAddVMOperations(vmMaps []map[string]interface{}, apiClient *taikungoclient.Client, projectID int32) []namedOperation {
+	operations := make([]namedOperation, len(vmMaps))
+	for i, vmMap := range vmMaps {
+		vmMap := vmMap
+		operations[i] = namedOperation{
+			name: fmt.Sprintf("create VM %s", vmMap["name"]),
+			run: func() error {
+				vmId, unreadableProperties, err := resourceTaikunProjectAddVM(vmMap, apiClient, projectID)
+				if err != nil {
+					return err
+				}
+				vmMap["id"] = vmId
+
+				for key, value := range unreadableProperties {
+					vmMap[key] = value
+				}
+				return nil
+			},
+		}
+	}
+	return operations
 }
 
 func findWithId(searchMap []map[string]interface{}, id string) map[string]interface{} {
@@ -261,6 +337,7 @@ func genVmRecreateFunc(cloudType string) func(old, new map[string]interface{}) b
 		// ForceNew fields within the VM subresource
 		return hasChanges(old, new,
 			"cloud_init",
+			"cloud_init_gzip",
 			"image_id",
 			"name",
 			"standalone_profile_id",
@@ -313,7 +390,7 @@ func computeDiff(oldMap []map[string]interface{}, newMap []map[string]interface{
 	return toDelete, toAdd, intersection
 }
 
-func resourceTaikunProjectUpdateVMs(ctx context.Context, d *schema.ResourceData, apiClient *taikungoclient.Client, projectID int32) error {
+func resourceTaikunProjectUpdateVMs(ctx context.Context, d *schema.ResourceData, apiClient *taikungoclient.Client, projectID int32) diag.Diagnostics {
 
 	oldVms, newVms := d.GetChange("vm")
 	oldVmsList := oldVms.([]interface{})
@@ -329,9 +406,10 @@ func resourceTaikunProjectUpdateVMs(ctx context.Context, d *schema.ResourceData,
 	cloudCredentialID, _ := atoi32(d.Get("cloud_credential_id").(string))
 	cloudType, err := resourceTaikunProjectGetCloudType(cloudCredentialID, apiClient)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
+	concurrency := d.Get("vm_concurrency").(int)
 	toDelete, toAdd, intersection := computeDiff(oldMap, newMap, genVmRecreateFunc(cloudType))
 
 	vmIds := make([]int32, 0)
@@ -351,103 +429,114 @@ func resourceTaikunProjectUpdateVMs(ctx context.Context, d *schema.ResourceData,
 		deleteVMParams := stand_alone.NewStandAloneDeleteParams().WithV(ApiVersion).WithBody(deleteServerBody)
 		_, err := apiClient.Client.StandAlone.StandAloneDelete(deleteVMParams, apiClient)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if err := resourceTaikunProjectWaitForStatus(ctx, []string{"Ready"}, []string{"PendingPurge", "Purging", "Deleting", "PendingDelete"}, apiClient, projectID); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
+	var diags diag.Diagnostics
 	if len(toAdd) != 0 {
-		vmsList := intersection
+		diags = runOperationsConcurrently(concurrency, resourceTaikunProjectAddVMOperations(toAdd, apiClient, projectID))
 
+		vmsList := intersection
 		for _, vmMap := range toAdd {
-
-			vmId, unreadableProperties, err := resourceTaikunProjectAddVM(vmMap, apiClient, projectID)
-			if err != nil {
-				return err
-			}
-			vmMap["id"] = vmId
-
-			for key, value := range unreadableProperties {
-				vmMap[key] = value
-			}
-
 			vmsList = append(vmsList, vmMap)
 		}
-		err := d.Set("vm", vmsList)
-		if err != nil {
-			return err
+		if err := d.Set("vm", vmsList); err != nil {
+			return append(diags, diag.FromErr(err)...)
 		}
 
+		// Commit the VMs which were created successfully
 		if err := resourceTaikunProjectStandaloneCommit(apiClient, projectID); err != nil {
-			return err
+			return append(diags, diag.FromErr(err)...)
 		}
 		if err := resourceTaikunProjectWaitForStatus(ctx, []string{"Ready"}, []string{"Updating", "Pending"}, apiClient, projectID); err != nil {
-			return err
+			return append(diags, diag.FromErr(err)...)
+		}
+		if diags.HasError() {
+			return diags
 		}
 	}
 
-	repairNeeded := false
+	operations := make([]namedOperation, 0)
 	for _, new := range intersection {
 		id := new["id"].(string)
 		vmId, _ := atoi32(id)
+		vmName := new["name"].(string)
 		if old := findWithId(oldMap, id); old != nil {
-
-			if hasChanges(old, new, "public_ip") {
-				repairNeeded = true
-				mode := "enable"
-				if !new["public_ip"].(bool) {
-					mode = "disable"
-				}
-				body := &models.StandAloneVMIPManagementCommand{
-					ID:   vmId,
-					Mode: mode,
-				}
-				params := stand_alone.NewStandAloneIPManagementParams().WithV(ApiVersion).WithBody(body)
-				_, err := apiClient.Client.StandAlone.StandAloneIPManagement(params, apiClient)
-				if err != nil {
-					return err
-				}
-			}
-			if hasChanges(old, new, "flavor") {
-				repairNeeded = true
-				body := &models.UpdateStandAloneVMFlavorCommand{
-					ID:     vmId,
-					Flavor: new["flavor"].(string),
-				}
-				params := stand_alone.NewStandAloneUpdateFlavorParams().WithV(ApiVersion).WithBody(body)
-				_, err := apiClient.Client.StandAlone.StandAloneUpdateFlavor(params, apiClient)
-				if err != nil {
-					return err
-				}
-			}
-
-			if hasChanges(old, new, "disk") {
-				repairNeeded = true
-				err := resourceTaikunProjectUpdateVMDisks(ctx, old["disk"], new["disk"], apiClient, vmId, projectID)
-				if err != nil {
-					return err
-				}
+			if !hasChanges(old, new, "public_ip", "flavor", "disk") {
+				continue
 			}
 
+			// The changes of a single VM are applied in order, only distinct VMs are updated concurrently
+			old, new := old, new
+			operations = append(operations, namedOperation{
+				name: fmt.Sprintf("update VM %s", vmName),
+				run: func() error {
+					if hasChanges(old, new, "public_ip") {
+						mode := "enable"
+						if !new["public_ip"].(bool) {
+							mode = "disable"
+						}
+						if err := resourceTaikunProjectUpdateVMPublicIP(vmId, mode, apiClient); err != nil {
+							return fmt.Errorf("failed to %s public IP: %w", mode, err)
+						}
+					}
+					if hasChanges(old, new, "flavor") {
+						if err := resourceTaikunProjectUpdateVMFlavor(vmId, new["flavor"].(string), apiClient); err != nil {
+							return fmt.Errorf("failed to update flavor: %w", err)
+						}
+					}
+					if hasChanges(old, new, "disk") {
+						if err := resourceTaikunProjectUpdateVMDisks(ctx, old["disk"], new["disk"], apiClient, vmId, projectID); err != nil {
+							return fmt.Errorf("failed to update disks: %w", err)
+						}
+					}
+					return nil
+				},
+			})
 		}
 		// Shouldn't happen
 	}
-	if repairNeeded {
+	if len(operations) != 0 {
+		diags = append(diags, runOperationsConcurrently(concurrency, operations)...)
+
+		// Repair even if some operations failed to apply those that succeeded
 		body := &models.RepairStandAloneVMCommand{ProjectID: projectID}
 		params := stand_alone.NewStandAloneRepairParams().WithV(ApiVersion).WithBody(body)
 		_, err := apiClient.Client.StandAlone.StandAloneRepair(params, apiClient)
 		if err != nil {
-			return err
+			return append(diags, diag.FromErr(err)...)
 		}
 		if err := resourceTaikunProjectWaitForStatus(ctx, []string{"Ready"}, []string{"Updating", "Pending"}, apiClient, projectID); err != nil {
-			return err
+			return append(diags, diag.FromErr(err)...)
 		}
 	}
 
-	return nil
+	return diags
+}
+
+func resourceTaikunProjectUpdateVMPublicIP(vmID int32, mode string, apiClient *taikungoclient.Client) error {
+	body := &models.StandAloneVMIPManagementCommand{
+		ID:   vmID,
+		Mode: mode,
+	}
+	params := stand_alone.NewStandAloneIPManagementParams().WithV(ApiVersion).WithBody(body)
+	_, err := apiClient.Client.StandAlone.StandAloneIPManagement(params, apiClient)
+	return err
+}
+
+func resourceTaikunProjectUpdateVMFlavor(vmID int32, flavor string, apiClient *taikungoclient.Client) error {
+	body := &models.UpdateStandAloneVMFlavorCommand{
+		ID:     vmID,
+		Flavor: flavor,
+	}
+	params := stand_alone.NewStandAloneUpdateFlavorParams().WithV(ApiVersion).WithBody(body)
+	_, err := apiClient.Client.StandAlone.StandAloneUpdateFlavor(params, apiClient)
+	return err
 }
 
 func resourceTaikunProjectUpdateVMDisks(ctx context.Context, oldDisks interface{}, newDisks interface{}, apiClient *taikungoclient.Client, vmID int32, projectID int32) error {
@@ -522,8 +611,13 @@ func resourceTaikunProjectAddVM(vmMap map[string]interface{}, apiClient *taikung
 	standaloneProfileId, _ := atoi32(vmMap["standalone_profile_id"].(string))
 	unreadableProperties := map[string]interface{}{}
 
+	cloudInit, err := resourceTaikunProjectVMCloudInitPayload(vmMap)
+	if err != nil {
+		return "", nil, err
+	}
+
 	vmCreateBody := &models.CreateStandAloneVMCommand{
-		CloudInit:           vmMap["cloud_init"].(string),
+		CloudInit:           cloudInit,
 		Count:               1,
 		FlavorName:          vmMap["flavor"].(string),
 		Image:               vmMap["image_id"].(string),
@@ -545,6 +639,10 @@ func resourceTaikunProjectAddVM(vmMap map[string]interface{}, apiClient *taikung
 		vmCreateBody.VolumeType = vmMap["volume_type"].(string)
 	}
 
+	if vmMap["windows_private_key"] != nil {
+		unreadableProperties["windows_private_key"] = vmMap["windows_private_key"].(string)
+	}
+
 	if vmMap["tag"] != nil {
 		rawTags := vmMap["tag"].(*schema.Set).List()
 		tagsList := make([]*models.StandAloneMetaDataDto, len(rawTags))
@@ -583,6 +681,251 @@ func resourceTaikunProjectAddVM(vmMap map[string]interface{}, apiClient *taikung
 	return vmCreateResponse.Payload.ID, unreadableProperties, nil
 }
 
+// resourceTaikunProjectValidateWindowsVMs checks at plan time that the
+// standalone profile of each Windows VM allows RDP, the only way to reach it
+func resourceTaikunProjectValidateWindowsVMs(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("vm", "vm_group") || !d.NewValueKnown("cloud_credential_id") {
+		return nil
+	}
+
+	vmList, _ := d.Get("vm").([]interface{})
+	groupList, _ := d.Get("vm_group").([]interface{})
+	for _, groupData := range groupList {
+		groupMap := groupData.(map[string]interface{})
+		vmList = append(vmList, resourceTaikunProjectVMGroupMember(groupMap, 0))
+	}
+	if len(vmList) == 0 {
+		return nil
+	}
+
+	apiClient := meta.(*taikungoclient.Client)
+	cloudCredentialID, _ := atoi32(d.Get("cloud_credential_id").(string))
+
+	imagesAreWindows := make(map[string]bool)
+	if d.Id() != "" {
+		projectID, _ := atoi32(d.Id())
+		boundImageDTOs, err := resourceTaikunProjectGetBoundImageDTOs(projectID, apiClient)
+		if err != nil {
+			return err
+		}
+		for _, boundImageDTO := range boundImageDTOs {
+			imagesAreWindows[boundImageDTO.ImageID] = boundImageDTO.IsWindows
+		}
+	}
+
+	profilesAllowRDP := make(map[int32]bool)
+	for _, vmData := range vmList {
+		vmMap := vmData.(map[string]interface{})
+		imageID, _ := vmMap["image_id"].(string)
+		standaloneProfileID, err := atoi32(vmMap["standalone_profile_id"].(string))
+		// Values unknown until apply are empty
+		if imageID == "" || err != nil {
+			continue
+		}
+
+		isWindows, found := imagesAreWindows[imageID]
+		if !found {
+			isWindows, err = resourceTaikunProjectImageIsWindows(cloudCredentialID, imageID, apiClient)
+			if err != nil {
+				tflog.Warn(ctx, "Skipping the RDP check of a VM whose image could not be looked up", map[string]interface{}{
+					"vm":       vmMap["name"],
+					"image_id": imageID,
+					"error":    err.Error(),
+				})
+				continue
+			}
+			imagesAreWindows[imageID] = isWindows
+		}
+		if !isWindows {
+			continue
+		}
+
+		allowsRDP, found := profilesAllowRDP[standaloneProfileID]
+		if !found {
+			securityGroups, err := resourceTaikunStandaloneProfileGetSecurityGroups(standaloneProfileID, apiClient)
+			if err != nil {
+				return err
+			}
+			allowsRDP = resourceTaikunStandaloneProfileAllowsRDP(securityGroups)
+			profilesAllowRDP[standaloneProfileID] = allowsRDP
+		}
+		if !allowsRDP {
+			return fmt.Errorf("VM %s runs a Windows image, the security_group of its standalone profile (%d) must allow TCP traffic on port %d (RDP)", vmMap["name"].(string), standaloneProfileID, rdpPort)
+		}
+	}
+
+	return nil
+}
+
+// Images are only flagged as Windows by the API once bound to a project,
+// before that their OS is derived from their details
+func resourceTaikunProjectImageIsWindows(cloudCredentialID int32, imageID string, apiClient *taikungoclient.Client) (bool, error) {
+	body := &models.ImageByIDCommand{
+		CloudID: cloudCredentialID,
+		ImageID: imageID,
+	}
+	params := images.NewImagesGetImageDetailsByIDParams().WithV(ApiVersion).WithBody(body)
+	response, err := apiClient.Client.Images.ImagesGetImageDetailsByID(params, apiClient)
+	if err != nil {
+		return false, err
+	}
+	return flattenTaikunImageOS(response.Payload, imageID)["os_family"] == "windows", nil
+}
+
+func resourceTaikunProjectSetVmWindowsPasswords(ctx context.Context, d *schema.ResourceData, apiClient *taikungoclient.Client) error {
+	vmList, ok := d.Get("vm").([]interface{})
+	if !ok || len(vmList) == 0 {
+		return nil
+	}
+
+	for _, vmData := range vmList {
+		vm := vmData.(map[string]interface{})
+		if isWindows, _ := vm["is_windows"].(bool); !isWindows {
+			continue
+		}
+		// The password is set once when the VM boots, no need to fetch it again
+		if password, _ := vm["windows_password"].(string); password != "" {
+			continue
+		}
+		vmId, err := atoi32(vm["id"].(string))
+		if err != nil {
+			continue
+		}
+		privateKey, _ := vm["windows_private_key"].(string)
+		password, err := resourceTaikunProjectGetVmWindowsPassword(vmId, privateKey, apiClient)
+		if err != nil {
+			// The password is only available once the VM has booted, it will be fetched again on the next refresh
+			tflog.Warn(ctx, "Could not retrieve the Windows password of a VM", map[string]interface{}{
+				"vm":    vm["name"],
+				"error": err.Error(),
+			})
+			continue
+		}
+		vm["windows_password"] = password
+	}
+
+	return d.Set("vm", vmList)
+}
+
+func resourceTaikunProjectGetVmWindowsPassword(vmID int32, privateKey string, apiClient *taikungoclient.Client) (string, error) {
+	params := stand_alone_actions.NewStandAloneActionsInstancePasswordParams().WithV(ApiVersion).WithID(&vmID)
+	if privateKey != "" {
+		params = params.WithKey(&privateKey)
+	}
+	response, err := apiClient.Client.StandAloneActions.StandAloneActionsInstancePassword(params, apiClient)
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(response.Payload, "\""), nil
+}
+
+// The API returns the addresses of all the VM's network interfaces in a single field
+func splitIPAddresses(addresses string) (ipv4Addresses []string, ipv6Addresses []string) {
+	ipv4Addresses, ipv6Addresses = make([]string, 0), make([]string, 0)
+	fields := strings.FieldsFunc(addresses, func(r rune) bool {
+		return r == ',' || r == ';' || unicode.IsSpace(r)
+	})
+	for _, field := range fields {
+		ip := net.ParseIP(field)
+		if ip == nil {
+			continue
+		}
+		if ip.To4() != nil {
+			ipv4Addresses = append(ipv4Addresses, field)
+		} else {
+			ipv6Addresses = append(ipv6Addresses, field)
+		}
+	}
+	return
+}
+
+// Maximum size in bytes of the cloud init accepted by each cloud provider
+var cloudInitMaxSize = map[string]int{
+	cloudTypeAWS:       16 * 1024,
+	cloudTypeAzure:     64 * 1024,
+	cloudTypeGCP:       256 * 1024,
+	cloudTypeOpenStack: 64 * 1024,
+}
+
+func resourceTaikunProjectVMCloudInitPayload(vmMap map[string]interface{}) (string, error) {
+	cloudInit, _ := vmMap["cloud_init"].(string)
+	if gzipEnabled, _ := vmMap["cloud_init_gzip"].(bool); !gzipEnabled || cloudInit == "" {
+		return cloudInit, nil
+	}
+	return gzipBase64Encode(cloudInit)
+}
+
+func resourceTaikunProjectValidateCloudInitSize(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("vm", "vm_group") || !d.NewValueKnown("cloud_credential_id") {
+		return nil
+	}
+
+	vmList, _ := d.Get("vm").([]interface{})
+	groupList, _ := d.Get("vm_group").([]interface{})
+	if len(vmList) == 0 && len(groupList) == 0 {
+		return nil
+	}
+
+	apiClient := meta.(*taikungoclient.Client)
+	cloudCredentialID, _ := atoi32(d.Get("cloud_credential_id").(string))
+	cloudType, err := resourceTaikunProjectGetCloudType(cloudCredentialID, apiClient)
+	if err != nil {
+		return err
+	}
+	maxSize, ok := cloudInitMaxSize[cloudType]
+	if !ok {
+		return nil
+	}
+
+	for _, groupData := range groupList {
+		groupMap := groupData.(map[string]interface{})
+		vmList = append(vmList, resourceTaikunProjectVMGroupMember(groupMap, 0))
+	}
+
+	for _, vmData := range vmList {
+		vmMap := vmData.(map[string]interface{})
+		payload, err := resourceTaikunProjectVMCloudInitPayload(vmMap)
+		if err != nil {
+			return err
+		}
+		if len(payload) > maxSize {
+			return fmt.Errorf("the cloud init of VM %s is %d bytes long, %s accepts at most %d bytes (consider enabling cloud_init_gzip)", vmMap["name"].(string), len(payload), cloudType, maxSize)
+		}
+	}
+
+	return nil
+}
+
+func gzipBase64Encode(s string) (string, error) {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(s)); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buffer.Bytes()), nil
+}
+
+// gzipBase64Decode returns the decoded string if s was encoded by gzipBase64Encode, s unchanged otherwise
+func gzipBase64Decode(s string) (string, bool) {
+	compressed, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return s, false
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return s, false
+	}
+	defer reader.Close()
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return s, false
+	}
+	return string(decompressed), true
+}
+
 func resourceTaikunProjectAddDisk(diskMap map[string]interface{}, apiClient *taikungoclient.Client, vmId int32) error {
 
 	diskCreateBody := &models.CreateStandAloneDiskCommand{
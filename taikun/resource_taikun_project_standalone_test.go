@@ -3,9 +3,11 @@ package taikun
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const testAccResourceTaikunProjectConfigWithImages = `
@@ -25,6 +27,65 @@ resource "taikun_project" "foo" {
 }
 `
 
+func TestGzipBase64RoundTrip(t *testing.T) {
+	cloudInit := "#cloud-config\npackages:\n  - nginx\n"
+
+	encoded, err := gzipBase64Encode(cloudInit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encoded == cloudInit {
+		t.Fatalf("expected %q to be encoded", cloudInit)
+	}
+
+	decoded, ok := gzipBase64Decode(encoded)
+	if !ok || decoded != cloudInit {
+		t.Errorf("expected %q to decode to %q, got %q", encoded, cloudInit, decoded)
+	}
+
+	if decoded, ok := gzipBase64Decode(cloudInit); ok || decoded != cloudInit {
+		t.Errorf("expected %q to be returned unchanged, got %q", cloudInit, decoded)
+	}
+}
+
+func TestResourceTaikunProjectDecodeVmCloudInits(t *testing.T) {
+	// A plain cloud init which happens to be valid base64 and gzip
+	plainCloudInit, err := gzipBase64Encode("#cloud-config\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceTaikunProjectSchema(), map[string]interface{}{
+		"vm": []interface{}{
+			map[string]interface{}{"id": "1", "cloud_init": "#cloud-config\n", "cloud_init_gzip": true},
+			map[string]interface{}{"id": "2", "cloud_init": plainCloudInit, "cloud_init_gzip": false},
+		},
+	})
+	projectMap := map[string]interface{}{
+		"vm": []map[string]interface{}{
+			{"id": "1", "cloud_init": plainCloudInit, "cloud_init_gzip": false},
+			{"id": "2", "cloud_init": plainCloudInit, "cloud_init_gzip": false},
+			// Imported or created outside of Terraform
+			{"id": "3", "cloud_init": plainCloudInit, "cloud_init_gzip": false},
+		},
+	}
+
+	resourceTaikunProjectDecodeVmCloudInits(d, projectMap)
+
+	expected := []map[string]interface{}{
+		{"cloud_init": "#cloud-config\n", "cloud_init_gzip": true},
+		{"cloud_init": plainCloudInit, "cloud_init_gzip": false},
+		{"cloud_init": plainCloudInit, "cloud_init_gzip": false},
+	}
+	for i, vm := range projectMap["vm"].([]map[string]interface{}) {
+		for key, value := range expected[i] {
+			if vm[key] != value {
+				t.Errorf("expected %s of VM %s to be %v, got %v", key, vm["id"], value, vm[key])
+			}
+		}
+	}
+}
+
 func TestAccResourceTaikunProjectModifyImages(t *testing.T) {
 	cloudCredentialName := randomTestName()
 	projectName := randomTestName()
@@ -227,6 +288,8 @@ func TestAccResourceTaikunProjectStandaloneOpenStackMinimalUpdateIP(t *testing.T
 					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.volume_size", "40"),
 					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.public_ip", "true"),
 					resource.TestCheckResourceAttrSet("taikun_project.foo", "vm.0.access_ip"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.private_ips.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.public_ips.#", "1"),
 					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.tag.#", "2"),
 					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.disk.#", "2"),
 				),
@@ -260,6 +323,47 @@ func TestAccResourceTaikunProjectStandaloneOpenStackMinimalUpdateIP(t *testing.T
 	})
 }
 
+func TestAccResourceTaikunProjectStandaloneOpenStackMinimalCloudInit(t *testing.T) {
+	cloudCredentialName := randomTestName()
+	standaloneProfileName := randomTestName()
+	projectName := shortRandomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectStandaloneOpenStackMinimal,
+					cloudCredentialName,
+					standaloneProfileName,
+					projectName,
+					0,
+					`cloud_init = "#cloud-config\npackages: [htop"`,
+				),
+				ExpectError: regexp.MustCompile("expected a valid #cloud-config YAML document"),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectStandaloneOpenStackMinimal,
+					cloudCredentialName,
+					standaloneProfileName,
+					projectName,
+					0,
+					`cloud_init = "#cloud-config\npackages:\n  - htop\n"
+    cloud_init_gzip = true`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunProjectExists,
+					resource.TestCheckResourceAttr("taikun_project.foo", "name", projectName),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.cloud_init", "#cloud-config\npackages:\n  - htop\n"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.cloud_init_gzip", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceTaikunProjectStandaloneOpenStackMinimalUpdateFlavor(t *testing.T) {
 	cloudCredentialName := randomTestName()
 	standaloneProfileName := randomTestName()
@@ -526,6 +630,95 @@ func TestAccResourceTaikunProjectStandaloneAWSMinimalUpdateFlavor(t *testing.T)
 	})
 }
 
+const testAccResourceTaikunProjectStandaloneAWSWindows = `
+resource "taikun_cloud_credential_aws" "foo" {
+  name = "%s"
+  availability_zone = "%s"
+}
+
+data "taikun_flavors" "foo" {
+  cloud_credential_id = resource.taikun_cloud_credential_aws.foo.id
+  min_cpu = 2
+  max_cpu = 2
+  min_ram = 4
+  max_ram = 8
+}
+
+locals {
+  flavors = [for flavor in data.taikun_flavors.foo.flavors: flavor.name]
+}
+
+resource "taikun_standalone_profile" "foo" {
+  name = "%s"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGQwGpzLk0IzqKnBpaHqecLA+X4zfHamNe9Rg3CoaXHF :oui_oui:"
+  %s
+}
+
+resource "taikun_project" "foo" {
+  name = "%s"
+  cloud_credential_id = resource.taikun_cloud_credential_aws.foo.id
+  flavors = local.flavors
+  images = ["ami-0c8d8b4c5ef1fdfe7"]
+
+  vm {
+    name = "my-windows-vm"
+    flavor = local.flavors[0]
+    image_id = "ami-0c8d8b4c5ef1fdfe7"
+    standalone_profile_id =  resource.taikun_standalone_profile.foo.id
+    volume_size = 40
+  }
+}
+`
+
+const testAccResourceTaikunProjectStandaloneAWSWindowsRDPSecurityGroup = `
+  security_group {
+    name = "rdp"
+    from_port = 3389
+    to_port = 3389
+    ip_protocol = "TCP"
+    cidr = "0.0.0.0/0"
+  }
+`
+
+func TestAccResourceTaikunProjectStandaloneAWSWindows(t *testing.T) {
+	cloudCredentialName := randomTestName()
+	standaloneProfileName := randomTestName()
+	projectName := shortRandomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWS(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectStandaloneAWSWindows,
+					cloudCredentialName,
+					os.Getenv("AWS_AVAILABILITY_ZONE"),
+					standaloneProfileName,
+					"",
+					projectName,
+				),
+				ExpectError: regexp.MustCompile("must allow TCP traffic on port 3389"),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectStandaloneAWSWindows,
+					cloudCredentialName,
+					os.Getenv("AWS_AVAILABILITY_ZONE"),
+					standaloneProfileName,
+					testAccResourceTaikunProjectStandaloneAWSWindowsRDPSecurityGroup,
+					projectName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunProjectExists,
+					resource.TestCheckResourceAttr("taikun_project.foo", "name", projectName),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.0.is_windows", "true"),
+				),
+			},
+		},
+	})
+}
+
 const testAccResourceTaikunProjectStandaloneAzureMinimal = `
 resource "taikun_cloud_credential_azure" "foo" {
   name = "%s"
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/itera-io/taikungoclient"
 	"github.com/itera-io/taikungoclient/client/projects"
@@ -508,3 +509,30 @@ func testAccCheckTaikunProjectDestroy(state *terraform.State) error {
 
 	return nil
 }
+
+func TestResourceTaikunProjectCheckAlertingProfileConflict(t *testing.T) {
+	testCases := []struct {
+		name              string
+		state             map[string]interface{}
+		alertingProfileID string
+		expectWarning     bool
+	}{
+		{"import", map[string]interface{}{}, "42", false},
+		{"unchanged", map[string]interface{}{"name": "foo", "alerting_profile_id": "42"}, "42", false},
+		{"attached outside", map[string]interface{}{"name": "foo"}, "42", true},
+		{"detached outside", map[string]interface{}{"name": "foo", "alerting_profile_id": "42"}, "", true},
+	}
+
+	for _, testCase := range testCases {
+		d := schema.TestResourceDataRaw(t, resourceTaikunProject().Schema, testCase.state)
+		projectMap := map[string]interface{}{}
+		if testCase.alertingProfileID != "" {
+			projectMap["alerting_profile_id"] = testCase.alertingProfileID
+		}
+
+		diags := resourceTaikunProjectCheckAlertingProfileConflict(d, projectMap)
+		if warned := len(diags) != 0; warned != testCase.expectWarning {
+			t.Errorf("%s: expected warning %t, got %v", testCase.name, testCase.expectWarning, diags)
+		}
+	}
+}
@@ -0,0 +1,514 @@
+package taikun
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/itera-io/taikungoclient"
+	"github.com/itera-io/taikungoclient/client/stand_alone"
+	"github.com/itera-io/taikungoclient/models"
+)
+
+func taikunVMGroupSchema() map[string]*schema.Schema {
+	groupSchema := taikunVMSchema()
+	deleteFieldsFromSchema(groupSchema,
+		"access_ip",
+		"created_by",
+		"id",
+		"image_name",
+		"ip",
+		"ipv6_addresses",
+		"is_windows",
+		"last_modified",
+		"last_modified_by",
+		"name",
+		"private_ips",
+		"public_ips",
+		"status",
+		"windows_password",
+		"windows_private_key",
+	)
+	deleteFieldsFromSchema(groupSchema["disk"].Elem.(*schema.Resource).Schema, "id")
+	groupSchema["disk"].Description = "Disks associated with each VM of the group (updating this field will recreate the group's VMs)."
+	groupSchema["flavor"].Description = "The flavor of the group's VMs."
+	groupSchema["public_ip"].Description = "Whether a public IP will be available for each VM of the group (updating this field will recreate the group's VMs if the project isn't hosted on OpenStack)."
+	for _, key := range []string{"cloud_init", "cloud_init_gzip", "image_id", "standalone_profile_id", "tag", "username", "volume_size", "volume_type"} {
+		groupSchema[key].Description = strings.Replace(groupSchema[key].Description, "recreate the VM", "recreate the group's VMs", 1)
+	}
+
+	setFieldInSchema(groupSchema, "count", &schema.Schema{
+		Description:  "Number of VMs in the group. Scaling the group removes VMs at the end of the group and adds VMs in the gaps left by deleted VMs, then at the end.",
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	})
+	setFieldInSchema(groupSchema, "name_prefix", &schema.Schema{
+		Description: "Prefix of the names of the group's VMs, the VMs are named `<name_prefix>-1`, `<name_prefix>-2`, etc.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, 47),
+			validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9-]+$"),
+				"expected only alpha numeric characters or non alpha numeric (-)",
+			),
+		),
+	})
+	setFieldInSchema(groupSchema, "vm", &schema.Schema{
+		Description: "VMs of the group.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_ip": {
+					Description: "Access IP of the VM.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"id": {
+					Description: "ID of the VM.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ip": {
+					Description: "IP of the VM.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"drifted_attributes": {
+					Description: "Attributes of the VM which differ from the group's, such as `flavor` or `image_id`. A VM which drifted from its group is recreated on the next apply.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"name": {
+					Description: "Name of the VM.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"status": {
+					Description: "VM status.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	})
+
+	return groupSchema
+}
+
+func vmGroupMemberName(namePrefix string, index int) string {
+	return fmt.Sprintf("%s-%d", namePrefix, index+1)
+}
+
+// vmGroupMemberIndex returns the index of the VM named vmName in the group, if it belongs to it
+func vmGroupMemberIndex(namePrefix string, vmName string) (int, bool) {
+	if !strings.HasPrefix(vmName, namePrefix+"-") {
+		return 0, false
+	}
+	number, err := strconv.Atoi(strings.TrimPrefix(vmName, namePrefix+"-"))
+	if err != nil || number < 1 {
+		return 0, false
+	}
+	return number - 1, true
+}
+
+// vmGroupMissingMemberIndexes returns the indexes of the members to create
+// for the group to have count members, filling the gaps left by deleted
+// members first so that no two members share a name
+func vmGroupMissingMemberIndexes(namePrefix string, vms []interface{}, count int) []int {
+	usedIndexes := make(map[int]bool, len(vms))
+	for _, vm := range vms {
+		if index, ok := vmGroupMemberIndex(namePrefix, vm.(map[string]interface{})["name"].(string)); ok {
+			usedIndexes[index] = true
+		}
+	}
+
+	indexes := make([]int, 0)
+	for i := 0; len(vms)+len(indexes) < count; i++ {
+		if !usedIndexes[i] {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func resourceTaikunProjectVMGroupMember(groupMap map[string]interface{}, index int) map[string]interface{} {
+	vmMap := make(map[string]interface{}, len(groupMap))
+	for key, value := range groupMap {
+		if key == "count" || key == "name_prefix" || key == "vm" {
+			continue
+		}
+		vmMap[key] = value
+	}
+	vmMap["name"] = vmGroupMemberName(groupMap["name_prefix"].(string), index)
+	return vmMap
+}
+
+func resourceTaikunProjectSetVMGroups(d *schema.ResourceData, apiClient *taikungoclient.Client, projectID int32) diag.Diagnostics {
+
+	groupsList := d.Get("vm_group").([]interface{})
+
+	toAdd := make([]vmGroupPendingMember, 0)
+	for _, group := range groupsList {
+		groupMap := group.(map[string]interface{})
+		groupMap["vm"] = make([]interface{}, 0)
+		for i := 0; i < groupMap["count"].(int); i++ {
+			toAdd = append(toAdd, vmGroupPendingMember{
+				group:  groupMap,
+				member: resourceTaikunProjectVMGroupMember(groupMap, i),
+			})
+		}
+	}
+
+	diags := resourceTaikunProjectAddVMGroupMembers(toAdd, d.Get("vm_concurrency").(int), apiClient, projectID)
+	if err := d.Set("vm_group", groupsList); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+// resourceTaikunProjectAddVMGroupMembers creates the pending members
+// concurrently and appends those created successfully to their group's VM list
+func resourceTaikunProjectAddVMGroupMembers(toAdd []vmGroupPendingMember, concurrency int, apiClient *taikungoclient.Client, projectID int32) diag.Diagnostics {
+	diags := runOperationsConcurrently(concurrency, resourceTaikunProjectAddVMOperations(vmGroupPendingMembers(toAdd), apiClient, projectID))
+
+	// Members are appended in order once all operations are done
+	for _, pending := range toAdd {
+		if vmId, created := pending.member["id"]; created {
+			pending.group["vm"] = append(pending.group["vm"].([]interface{}), map[string]interface{}{
+				"id":   vmId,
+				"name": pending.member["name"],
+			})
+		}
+	}
+
+	return diags
+}
+
+type vmGroupPendingMember struct {
+	group  map[string]interface{}
+	member map[string]interface{}
+}
+
+// vmGroupMemberUpdate is a VM kept in its group whose flavor or public IP changed
+type vmGroupMemberUpdate struct {
+	name string
+	old  map[string]interface{}
+	new  map[string]interface{}
+}
+
+func vmGroupPendingMembers(pendingMembers []vmGroupPendingMember) []map[string]interface{} {
+	members := make([]map[string]interface{}, len(pendingMembers))
+	for i, pending := range pendingMembers {
+		members[i] = pending.member
+	}
+	return members
+}
+
+func resourceTaikunProjectUpdateVMGroups(ctx context.Context, d *schema.ResourceData, apiClient *taikungoclient.Client, projectID int32) diag.Diagnostics {
+
+	oldGroupsData, newGroupsData := d.GetChange("vm_group")
+	oldGroups := make(map[string]map[string]interface{})
+	for _, e := range oldGroupsData.([]interface{}) {
+		groupMap := e.(map[string]interface{})
+		oldGroups[groupMap["name_prefix"].(string)] = groupMap
+	}
+	newGroupsList := newGroupsData.([]interface{})
+
+	cloudCredentialID, _ := atoi32(d.Get("cloud_credential_id").(string))
+	cloudType, err := resourceTaikunProjectGetCloudType(cloudCredentialID, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	concurrency := d.Get("vm_concurrency").(int)
+	recreateFunc := genVmRecreateFunc(cloudType)
+
+	vmIdsToDelete := make([]int32, 0)
+	toAdd := make([]vmGroupPendingMember, 0)
+	toUpdate := make(map[int32]vmGroupMemberUpdate)
+
+	for _, e := range newGroupsList {
+		new := e.(map[string]interface{})
+		namePrefix := new["name_prefix"].(string)
+		newCount := new["count"].(int)
+		keptVMs := make([]interface{}, 0)
+
+		old, found := oldGroups[namePrefix]
+		delete(oldGroups, namePrefix)
+
+		oldVMs := make([]interface{}, 0)
+		if found {
+			oldVMs = old["vm"].([]interface{})
+		}
+
+		if !found || recreateFunc(old, new) || hasChanges(old, new, "disk") {
+			vmIdsToDelete = append(vmIdsToDelete, vmGroupMemberIds(oldVMs)...)
+		} else {
+			for _, vm := range oldVMs {
+				// Drifted members are recreated in their place
+				if len(keptVMs) >= newCount || vmGroupMemberIsDrifted(vm.(map[string]interface{})) {
+					vmIdsToDelete = append(vmIdsToDelete, vmGroupMemberIds([]interface{}{vm})...)
+					continue
+				}
+				keptVMs = append(keptVMs, vm)
+
+				if hasChanges(old, new, "public_ip", "flavor") {
+					vmMap := vm.(map[string]interface{})
+					vmId, _ := atoi32(vmMap["id"].(string))
+					toUpdate[vmId] = vmGroupMemberUpdate{
+						name: vmMap["name"].(string),
+						old:  old,
+						new:  new,
+					}
+				}
+			}
+		}
+
+		for _, i := range vmGroupMissingMemberIndexes(namePrefix, keptVMs, newCount) {
+			toAdd = append(toAdd, vmGroupPendingMember{
+				group:  new,
+				member: resourceTaikunProjectVMGroupMember(new, i),
+			})
+		}
+		new["vm"] = keptVMs
+	}
+
+	// Groups which are no longer in the list will be deleted
+	for _, old := range oldGroups {
+		vmIdsToDelete = append(vmIdsToDelete, vmGroupMemberIds(old["vm"].([]interface{}))...)
+	}
+
+	if len(vmIdsToDelete) != 0 {
+		deleteServerBody := &models.DeleteStandAloneVMCommand{
+			ProjectID: projectID,
+			VMIds:     vmIdsToDelete,
+		}
+		deleteVMParams := stand_alone.NewStandAloneDeleteParams().WithV(ApiVersion).WithBody(deleteServerBody)
+		if _, err := apiClient.Client.StandAlone.StandAloneDelete(deleteVMParams, apiClient); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := resourceTaikunProjectWaitForStatus(ctx, []string{"Ready"}, []string{"PendingPurge", "Purging", "Deleting", "PendingDelete"}, apiClient, projectID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	diags := resourceTaikunProjectAddVMGroupMembers(toAdd, concurrency, apiClient, projectID)
+
+	if err := d.Set("vm_group", newGroupsList); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if len(toAdd) != 0 {
+		// Commit the VMs which were created successfully
+		if err := resourceTaikunProjectStandaloneCommit(apiClient, projectID); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := resourceTaikunProjectWaitForStatus(ctx, []string{"Ready"}, []string{"Updating", "Pending"}, apiClient, projectID); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	operations := make([]namedOperation, 0)
+	for vmId, update := range toUpdate {
+		vmId, update := vmId, update
+		// The changes of a single VM are applied in order, only distinct VMs are updated concurrently
+		operations = append(operations, namedOperation{
+			name: fmt.Sprintf("update VM %s", update.name),
+			run: func() error {
+				if hasChanges(update.old, update.new, "public_ip") {
+					mode := "enable"
+					if !update.new["public_ip"].(bool) {
+						mode = "disable"
+					}
+					if err := resourceTaikunProjectUpdateVMPublicIP(vmId, mode, apiClient); err != nil {
+						return fmt.Errorf("failed to %s public IP: %w", mode, err)
+					}
+				}
+				if hasChanges(update.old, update.new, "flavor") {
+					if err := resourceTaikunProjectUpdateVMFlavor(vmId, update.new["flavor"].(string), apiClient); err != nil {
+						return fmt.Errorf("failed to update flavor: %w", err)
+					}
+				}
+				return nil
+			},
+		})
+	}
+
+	if len(operations) != 0 {
+		diags = append(diags, runOperationsConcurrently(concurrency, operations)...)
+
+		// Repair even if some operations failed to apply those that succeeded
+		body := &models.RepairStandAloneVMCommand{ProjectID: projectID}
+		params := stand_alone.NewStandAloneRepairParams().WithV(ApiVersion).WithBody(body)
+		if _, err := apiClient.Client.StandAlone.StandAloneRepair(params, apiClient); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := resourceTaikunProjectWaitForStatus(ctx, []string{"Ready"}, []string{"Updating", "Pending"}, apiClient, projectID); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+func vmGroupMemberIds(vms []interface{}) []int32 {
+	vmIds := make([]int32, 0)
+	for _, vm := range vms {
+		vmMap := vm.(map[string]interface{})
+		if vmId, err := atoi32(vmMap["id"].(string)); err == nil && vmId != 0 {
+			vmIds = append(vmIds, vmId)
+		}
+	}
+	return vmIds
+}
+
+// vmGroupMemberDriftedAttributes returns the attributes of a group member
+// read from the API which differ from the group's
+func vmGroupMemberDriftedAttributes(group map[string]interface{}, vm map[string]interface{}) []string {
+	drifted := make([]string, 0)
+	for _, key := range []string{"flavor", "image_id", "standalone_profile_id"} {
+		if fmt.Sprint(group[key]) != fmt.Sprint(vm[key]) {
+			drifted = append(drifted, key)
+		}
+	}
+
+	groupDiskSizes := make(map[string]int)
+	groupDisks, _ := group["disk"].([]interface{})
+	for _, disk := range groupDisks {
+		diskMap := disk.(map[string]interface{})
+		groupDiskSizes[diskMap["name"].(string)] = diskMap["size"].(int)
+	}
+	vmDisks, _ := vm["disk"].([]map[string]interface{})
+	vmDisksMatch := len(vmDisks) == len(groupDiskSizes)
+	for _, disk := range vmDisks {
+		if size, found := groupDiskSizes[disk["name"].(string)]; !found || fmt.Sprint(size) != fmt.Sprint(disk["size"]) {
+			vmDisksMatch = false
+		}
+	}
+	if !vmDisksMatch {
+		drifted = append(drifted, "disk")
+	}
+
+	return drifted
+}
+
+// vmGroupMemberIsDrifted tells whether a group member read from the state
+// drifted from its group
+func vmGroupMemberIsDrifted(vm map[string]interface{}) bool {
+	drifted, _ := vm["drifted_attributes"].([]interface{})
+	return len(drifted) != 0
+}
+
+// resourceTaikunProjectFlattenVMGroups moves the VMs belonging to a group
+// from the project's `vm` list to the group's `vm` list.
+// The members which drifted from their group are not counted, so that the
+// next apply recreates them.
+func resourceTaikunProjectFlattenVMGroups(ctx context.Context, d *schema.ResourceData, projectMap map[string]interface{}) {
+	groupsList, ok := d.Get("vm_group").([]interface{})
+	if !ok || len(groupsList) == 0 {
+		return
+	}
+
+	type indexedVM struct {
+		index int
+		vm    map[string]interface{}
+	}
+	groupVMs := make([][]indexedVM, len(groupsList))
+
+	vms := make([]map[string]interface{}, 0)
+	for _, vm := range projectMap["vm"].([]map[string]interface{}) {
+		isGroupMember := false
+		for i, group := range groupsList {
+			namePrefix := group.(map[string]interface{})["name_prefix"].(string)
+			if index, ok := vmGroupMemberIndex(namePrefix, vm["name"].(string)); ok {
+				groupVMs[i] = append(groupVMs[i], indexedVM{index: index, vm: vm})
+				isGroupMember = true
+				break
+			}
+		}
+		if !isGroupMember {
+			vms = append(vms, vm)
+		}
+	}
+
+	groups := make([]map[string]interface{}, len(groupsList))
+	for i, group := range groupsList {
+		groupMap := group.(map[string]interface{})
+		sort.Slice(groupVMs[i], func(a, b int) bool {
+			return groupVMs[i][a].index < groupVMs[i][b].index
+		})
+		members := make([]map[string]interface{}, len(groupVMs[i]))
+		count := 0
+		for j, member := range groupVMs[i] {
+			driftedAttributes := vmGroupMemberDriftedAttributes(groupMap, member.vm)
+			if len(driftedAttributes) == 0 {
+				count++
+			} else {
+				tflog.Warn(ctx, "A VM drifted from its group and will be recreated", map[string]interface{}{
+					"name":               member.vm["name"],
+					"drifted_attributes": driftedAttributes,
+				})
+			}
+			members[j] = map[string]interface{}{
+				"access_ip":          member.vm["access_ip"],
+				"drifted_attributes": driftedAttributes,
+				"id":                 member.vm["id"],
+				"ip":                 member.vm["ip"],
+				"name":               member.vm["name"],
+				"status":             member.vm["status"],
+			}
+		}
+		groupMap["count"] = count
+		groupMap["vm"] = members
+		groups[i] = groupMap
+	}
+
+	projectMap["vm"] = vms
+	projectMap["vm_group"] = groups
+}
+
+func resourceTaikunProjectValidateVMGroupNames(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	groupsList, ok := d.Get("vm_group").([]interface{})
+	if !ok || len(groupsList) == 0 {
+		return nil
+	}
+
+	namePrefixes := make([]string, 0)
+	for _, group := range groupsList {
+		namePrefix := group.(map[string]interface{})["name_prefix"].(string)
+		for _, other := range namePrefixes {
+			if namePrefix == other {
+				return fmt.Errorf("vm_group name prefixes must be unique: %s", namePrefix)
+			}
+		}
+		namePrefixes = append(namePrefixes, namePrefix)
+	}
+
+	vmList, _ := d.Get("vm").([]interface{})
+	for _, vm := range vmList {
+		vmName := vm.(map[string]interface{})["name"].(string)
+		for _, namePrefix := range namePrefixes {
+			if _, ok := vmGroupMemberIndex(namePrefix, vmName); ok {
+				return fmt.Errorf("VM name %s is reserved for the VMs of the vm_group with name prefix %s", vmName, namePrefix)
+			}
+		}
+	}
+
+	return nil
+}
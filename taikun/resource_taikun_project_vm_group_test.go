@@ -0,0 +1,207 @@
+package taikun
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccResourceTaikunProjectVMGroupOpenStack = `
+resource "taikun_cloud_credential_openstack" "foo" {
+  name = "%s"
+}
+
+data "taikun_flavors" "foo" {
+  cloud_credential_id = resource.taikun_cloud_credential_openstack.foo.id
+  min_cpu = 2
+  max_cpu = 2
+  min_ram = 4
+  max_ram = 8
+}
+
+data "taikun_images" "foo" {
+  cloud_credential_id = resource.taikun_cloud_credential_openstack.foo.id
+}
+
+locals {
+  images = [for image in data.taikun_images.foo.images: image.id]
+  flavors = [for flavor in data.taikun_flavors.foo.flavors: flavor.name]
+}
+
+resource "taikun_standalone_profile" "foo" {
+  name = "%s"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGQwGpzLk0IzqKnBpaHqecLA+X4zfHamNe9Rg3CoaXHF :oui_oui:"
+}
+
+resource "taikun_project" "foo" {
+  name = "%s"
+  cloud_credential_id = resource.taikun_cloud_credential_openstack.foo.id
+  flavors = local.flavors
+  images = local.images
+
+  quota_vm_cpu_units = 64
+  quota_vm_ram_size = 256
+  quota_vm_volume_size = 512
+
+  vm {
+    name = "my-vm"
+    flavor = local.flavors[0]
+    image_id = local.images[0]
+    standalone_profile_id = resource.taikun_standalone_profile.foo.id
+    volume_size = 40
+  }
+
+  vm_group {
+    name_prefix = "worker"
+    count = %d
+    flavor = local.flavors[0]
+    image_id = local.images[0]
+    standalone_profile_id = resource.taikun_standalone_profile.foo.id
+    volume_size = 40
+    tag {
+      key = "role"
+      value = "worker"
+    }
+  }
+}
+`
+
+func TestAccResourceTaikunProjectVMGroupOpenStackScale(t *testing.T) {
+	cloudCredentialName := randomTestName()
+	standaloneProfileName := randomTestName()
+	projectName := shortRandomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckOpenStack(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTaikunProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectVMGroupOpenStack,
+					cloudCredentialName,
+					standaloneProfileName,
+					projectName,
+					2,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunProjectExists,
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.count", "2"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.vm.#", "2"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.vm.0.name", "worker-1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.vm.1.name", "worker-2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectVMGroupOpenStack,
+					cloudCredentialName,
+					standaloneProfileName,
+					projectName,
+					3,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunProjectExists,
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.count", "3"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.vm.#", "3"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.vm.2.name", "worker-3"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceTaikunProjectVMGroupOpenStack,
+					cloudCredentialName,
+					standaloneProfileName,
+					projectName,
+					1,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaikunProjectExists,
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.count", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.vm.#", "1"),
+					resource.TestCheckResourceAttr("taikun_project.foo", "vm_group.0.vm.0.name", "worker-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestVMGroupMissingMemberIndexes(t *testing.T) {
+	members := func(names ...string) []interface{} {
+		vms := make([]interface{}, len(names))
+		for i, name := range names {
+			vms[i] = map[string]interface{}{"name": name}
+		}
+		return vms
+	}
+
+	testCases := []struct {
+		vms      []interface{}
+		count    int
+		expected []int
+	}{
+		{members(), 3, []int{0, 1, 2}},
+		{members("web-1", "web-2"), 4, []int{2, 3}},
+		{members("web-1", "web-2", "web-4"), 3, []int{}},
+		{members("web-1", "web-2", "web-4"), 4, []int{2}},
+		{members("web-1", "web-2", "web-4"), 6, []int{2, 4, 5}},
+		{members("web-3"), 2, []int{0}},
+	}
+	for _, testCase := range testCases {
+		indexes := vmGroupMissingMemberIndexes("web", testCase.vms, testCase.count)
+		if !reflect.DeepEqual(indexes, testCase.expected) {
+			t.Errorf("expected indexes %v for %v scaled to %d, got %v", testCase.expected, testCase.vms, testCase.count, indexes)
+		}
+
+		names := make(map[string]bool)
+		for _, vm := range testCase.vms {
+			names[vm.(map[string]interface{})["name"].(string)] = true
+		}
+		for _, index := range indexes {
+			name := vmGroupMemberName("web", index)
+			if names[name] {
+				t.Errorf("member %s would be created twice", name)
+			}
+			names[name] = true
+		}
+	}
+}
+
+func TestVMGroupMemberDriftedAttributes(t *testing.T) {
+	group := map[string]interface{}{
+		"flavor":                "m1.small",
+		"image_id":              "image",
+		"standalone_profile_id": "42",
+		"disk": []interface{}{
+			map[string]interface{}{"name": "data", "size": 30},
+		},
+	}
+	member := func(flavor string, imageID string, diskSize int32) map[string]interface{} {
+		return map[string]interface{}{
+			"flavor":                flavor,
+			"image_id":              imageID,
+			"standalone_profile_id": "42",
+			"disk": []map[string]interface{}{
+				{"name": "data", "size": diskSize},
+			},
+		}
+	}
+
+	testCases := []struct {
+		vm       map[string]interface{}
+		expected []string
+	}{
+		{member("m1.small", "image", 30), []string{}},
+		{member("m1.large", "image", 30), []string{"flavor"}},
+		{member("m1.small", "other-image", 60), []string{"image_id", "disk"}},
+	}
+
+	for _, testCase := range testCases {
+		if drifted := vmGroupMemberDriftedAttributes(group, testCase.vm); !reflect.DeepEqual(drifted, testCase.expected) {
+			t.Errorf("expected %v to drift, got %v", testCase.expected, drifted)
+		}
+	}
+}
@@ -16,11 +16,21 @@ import (
 
 func resourceTaikunStandaloneProfileSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
+		"fingerprint_sha256": {
+			Description: "The SHA256 fingerprint of the public key.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		"id": {
 			Description: "The ID of the standalone profile.",
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"key_type": {
+			Description: "The type of the public key, for example `ssh-ed25519`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		"lock": {
 			Description: "Indicates whether to lock the standalone profile.",
 			Type:        schema.TypeBool,
@@ -53,11 +63,12 @@ func resourceTaikunStandaloneProfileSchema() map[string]*schema.Schema {
 			Computed:    true,
 		},
 		"public_key": {
-			Description:  "The public key of the standalone profile.",
-			Type:         schema.TypeString,
-			Required:     true,
-			ForceNew:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			Description:      "The public key of the standalone profile, in the OpenSSH format. The supported key types are ed25519, RSA of at least 2048 bits, ECDSA and their security key (`sk-`) variants. Whitespace and comment changes are ignored.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: stringIsSSHPublicKey,
+			DiffSuppressFunc: sshPublicKeyDiffSuppressFunc,
 		},
 		"security_group": {
 			Description: "List of security groups.",
@@ -131,7 +142,7 @@ func resourceTaikunStandaloneProfileCreate(ctx context.Context, d *schema.Resour
 
 	body := &models.StandAloneProfileCreateCommand{
 		Name:      d.Get("name").(string),
-		PublicKey: d.Get("public_key").(string),
+		PublicKey: normalizeSSHPublicKey(d.Get("public_key").(string)),
 	}
 
 	if securityGroups, isSecurityGroupsSet := d.GetOk("security_group"); isSecurityGroupsSet {
@@ -334,14 +345,18 @@ func flattenTaikunStandaloneProfile(rawStandaloneProfile *models.StandAloneProfi
 		}
 	}
 
+	fingerprint, keyType := getSSHPublicKeyFingerprintAndType(rawStandaloneProfile.PublicKey)
+
 	return map[string]interface{}{
-		"id":                i32toa(rawStandaloneProfile.ID),
-		"lock":              rawStandaloneProfile.IsLocked,
-		"name":              rawStandaloneProfile.Name,
-		"organization_id":   i32toa(rawStandaloneProfile.OrganizationID),
-		"organization_name": rawStandaloneProfile.OrganizationName,
-		"public_key":        rawStandaloneProfile.PublicKey,
-		"security_group":    securityGroups,
+		"fingerprint_sha256": fingerprint,
+		"id":                 i32toa(rawStandaloneProfile.ID),
+		"key_type":           keyType,
+		"lock":               rawStandaloneProfile.IsLocked,
+		"name":               rawStandaloneProfile.Name,
+		"organization_id":    i32toa(rawStandaloneProfile.OrganizationID),
+		"organization_name":  rawStandaloneProfile.OrganizationName,
+		"public_key":         rawStandaloneProfile.PublicKey,
+		"security_group":     securityGroups,
 	}
 }
 
@@ -354,3 +369,28 @@ func resourceTaikunStandaloneProfileLock(id int32, lock bool, apiClient *taikung
 	_, err := apiClient.Client.StandAloneProfile.StandAloneProfileLockManagement(params, apiClient)
 	return err
 }
+
+const rdpPort = 3389
+
+func resourceTaikunStandaloneProfileGetSecurityGroups(id int32, apiClient *taikungoclient.Client) ([]*models.SecurityGroupListDto, error) {
+	params := security_group.NewSecurityGroupListParams().WithV(ApiVersion).WithStandAloneProfileID(id)
+	response, err := apiClient.Client.SecurityGroup.SecurityGroupList(params, apiClient)
+	if err != nil {
+		return nil, err
+	}
+	return response.GetPayload(), nil
+}
+
+func resourceTaikunStandaloneProfileAllowsRDP(securityGroups []*models.SecurityGroupListDto) bool {
+	for _, securityGroup := range securityGroups {
+		if getSecurityGroupProtocol(securityGroup.Protocol) != getSecurityGroupProtocol("TCP") {
+			continue
+		}
+		// A port range of -1 means the range is unbounded
+		if (securityGroup.PortMinRange == -1 || securityGroup.PortMinRange <= rdpPort) &&
+			(securityGroup.PortMaxRange == -1 || securityGroup.PortMaxRange >= rdpPort) {
+			return true
+		}
+	}
+	return false
+}
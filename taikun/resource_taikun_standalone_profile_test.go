@@ -65,6 +65,8 @@ func TestAccResourceTaikunStandaloneProfile(t *testing.T) {
 					resource.TestCheckResourceAttr("taikun_standalone_profile.foo", "name", name),
 					resource.TestCheckResourceAttr("taikun_standalone_profile.foo", "lock", "false"),
 					resource.TestCheckResourceAttrSet("taikun_standalone_profile.foo", "public_key"),
+					resource.TestCheckResourceAttr("taikun_standalone_profile.foo", "key_type", "ssh-ed25519"),
+					resource.TestCheckResourceAttr("taikun_standalone_profile.foo", "fingerprint_sha256", "SHA256:Fpy4qO8DUccSuE63CDgaWLM6WgRQsBT3cFR6fT75Yxg"),
 					resource.TestCheckResourceAttrSet("taikun_standalone_profile.foo", "organization_id"),
 					resource.TestCheckResourceAttrSet("taikun_standalone_profile.foo", "organization_name"),
 					resource.TestCheckResourceAttr("taikun_standalone_profile.foo", "security_group.#", "2"),
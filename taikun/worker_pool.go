@@ -0,0 +1,62 @@
+package taikun
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const defaultVMConcurrency = 5
+
+// vmConcurrencyDefaultFunc lets TAIKUN_VM_CONCURRENCY override the default
+// concurrency of all the projects
+func vmConcurrencyDefaultFunc() (interface{}, error) {
+	if value := os.Getenv("TAIKUN_VM_CONCURRENCY"); value != "" {
+		return strconv.Atoi(value)
+	}
+	return defaultVMConcurrency, nil
+}
+
+type namedOperation struct {
+	// Description of the operation, e.g. "create VM my-vm"
+	name string
+	run  func() error
+}
+
+// runOperationsConcurrently runs the operations with at most concurrency
+// operations in flight and returns one diagnostic per failed operation
+func runOperationsConcurrently(concurrency int, operations []namedOperation) diag.Diagnostics {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var diagnostics diag.Diagnostics
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for _, operation := range operations {
+		waitGroup.Add(1)
+		semaphore <- struct{}{}
+		go func(operation namedOperation) {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+
+			if err := operation.run(); err != nil {
+				mutex.Lock()
+				diagnostics = append(diagnostics, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("failed to %s", operation.name),
+					Detail:   err.Error(),
+				})
+				mutex.Unlock()
+			}
+		}(operation)
+	}
+
+	waitGroup.Wait()
+	return diagnostics
+}
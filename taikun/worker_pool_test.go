@@ -0,0 +1,95 @@
+package taikun
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestRunOperationsConcurrently(t *testing.T) {
+	testCases := []struct {
+		concurrency         int
+		operationCount      int
+		failingVMs          map[int]bool
+		expectedMaxInFlight int
+	}{
+		{concurrency: 1, operationCount: 4, expectedMaxInFlight: 1},
+		{concurrency: 3, operationCount: 10, failingVMs: map[int]bool{2: true, 7: true}, expectedMaxInFlight: 3},
+		{concurrency: 5, operationCount: 3, failingVMs: map[int]bool{0: true, 1: true, 2: true}, expectedMaxInFlight: 3},
+		{concurrency: 0, operationCount: 3, failingVMs: map[int]bool{1: true}, expectedMaxInFlight: 1},
+		{concurrency: 2, operationCount: 0, expectedMaxInFlight: 0},
+	}
+
+	for _, testCase := range testCases {
+		var mutex sync.Mutex
+		inFlight, maxInFlight, runCount := 0, 0, 0
+		// The operations wait until the expected number of them are in flight
+		full := make(chan struct{})
+
+		operations := make([]namedOperation, testCase.operationCount)
+		for i := range operations {
+			i := i
+			operations[i] = namedOperation{
+				name: fmt.Sprintf("create VM vm-%d", i),
+				run: func() error {
+					mutex.Lock()
+					inFlight++
+					runCount++
+					if inFlight > maxInFlight {
+						maxInFlight = inFlight
+						if maxInFlight == testCase.expectedMaxInFlight {
+							close(full)
+						}
+					}
+					mutex.Unlock()
+
+					select {
+					case <-full:
+					case <-time.After(5 * time.Second):
+						return errors.New("timed out waiting for concurrent operations")
+					}
+
+					mutex.Lock()
+					inFlight--
+					mutex.Unlock()
+
+					if testCase.failingVMs[i] {
+						return errors.New("quota exceeded")
+					}
+					return nil
+				},
+			}
+		}
+
+		diags := runOperationsConcurrently(testCase.concurrency, operations)
+
+		if runCount != testCase.operationCount {
+			t.Errorf("concurrency %d: expected %d operations to run, got %d", testCase.concurrency, testCase.operationCount, runCount)
+		}
+		if maxInFlight != testCase.expectedMaxInFlight {
+			t.Errorf("concurrency %d: expected at most %d operations in flight, got %d", testCase.concurrency, testCase.expectedMaxInFlight, maxInFlight)
+		}
+
+		expectedSummaries := make([]string, 0)
+		for i := range testCase.failingVMs {
+			expectedSummaries = append(expectedSummaries, fmt.Sprintf("failed to create VM vm-%d", i))
+		}
+		summaries := make([]string, 0)
+		for _, d := range diags {
+			if d.Severity != diag.Error || d.Detail != "quota exceeded" {
+				t.Errorf("concurrency %d: unexpected diagnostic %v", testCase.concurrency, d)
+			}
+			summaries = append(summaries, d.Summary)
+		}
+		sort.Strings(expectedSummaries)
+		sort.Strings(summaries)
+		if fmt.Sprint(summaries) != fmt.Sprint(expectedSummaries) {
+			t.Errorf("concurrency %d: expected diagnostics %v, got %v", testCase.concurrency, expectedSummaries, summaries)
+		}
+	}
+}